@@ -42,6 +42,7 @@ val services = mapOf(
     "codebuild" to ServiceSpec("CodeBuild"),
     "codecatalyst" to ServiceSpec("CodeCatalyst"),
     "codecommit" to ServiceSpec("CodeCommit"),
+    "codeguruprofiler" to ServiceSpec("CodeGuru Profiler"),
     "codegurureviewer" to ServiceSpec("CodeGuru Reviewer"),
     "codepipeline" to ServiceSpec("CodePipeline"),
     "codestarconnections" to ServiceSpec("CodeStar Connections"),
@@ -61,6 +62,7 @@ val services = mapOf(
     "deploy" to ServiceSpec("CodeDeploy"),
     "detective" to ServiceSpec("Detective"),
     "devicefarm" to ServiceSpec("Device Farm"),
+    "devopsguru" to ServiceSpec("DevOps Guru"),
     "directconnect" to ServiceSpec("Direct Connect", vpcLock = true),
     "dlm" to ServiceSpec("DLM (Data Lifecycle Manager)"),
     "dms" to ServiceSpec("DMS (Database Migration)", vpcLock = true),
@@ -89,6 +91,7 @@ val services = mapOf(
     "firehose" to ServiceSpec("Kinesis Firehose"),
     "fis" to ServiceSpec("FIS (Fault Injection Simulator)"),
     "fms" to ServiceSpec("FMS (Firewall Manager)", regionOverride = "us-east-1"),
+    "frauddetector" to ServiceSpec("Fraud Detector"),
     "fsx" to ServiceSpec("FSx", vpcLock = true),
     "gamelift" to ServiceSpec("GameLift"),
     "glacier" to ServiceSpec("S3 Glacier"),
@@ -123,6 +126,8 @@ val services = mapOf(
     "location" to ServiceSpec("Location"),
     "logs" to ServiceSpec("CloudWatch Logs"),
     "macie2" to ServiceSpec("Macie"),
+    "managedblockchain" to ServiceSpec("Managed Blockchain"),
+    "managedblockchainquery" to ServiceSpec("Managed Blockchain Query"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
     "medialive" to ServiceSpec("Elemental MediaLive"),
@@ -142,6 +147,7 @@ val services = mapOf(
     "outposts" to ServiceSpec("Outposts"),
     "pinpoint" to ServiceSpec("Pinpoint"),
     "pipes" to ServiceSpec("EventBridge Pipes"),
+    "polly" to ServiceSpec("Polly"),
     "pricing" to ServiceSpec("Pricing Calculator", regionOverride = "us-east-1"),
     "qldb" to ServiceSpec("QLDB (Quantum Ledger Database)"),
     "quicksight" to ServiceSpec("QuickSight"),
@@ -151,6 +157,7 @@ val services = mapOf(
     "redshift" to ServiceSpec("Redshift", vpcLock = true),
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
+    "rekognition" to ServiceSpec("Rekognition"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
@@ -193,6 +200,7 @@ val services = mapOf(
     "timestreamwrite" to ServiceSpec("Timestream Write"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
+    "translate" to ServiceSpec("Translate"),
     "verifiedpermissions" to ServiceSpec("Verified Permissions"),
     "vpclattice" to ServiceSpec("VPC Lattice"),
     "waf" to ServiceSpec("WAF Classic", regionOverride = "us-east-1"),
@@ -190,6 +190,7 @@ variable "service_labels" {
     "macie",
     "macie2",
     "managedblockchain",
+    "managedblockchainquery",
     "marketplacecatalog",
     "marketplacecommerceanalytics",
     "marketplaceentitlement",
@@ -96,6 +96,7 @@ import (
 	codebuild_sdkv1 "github.com/aws/aws-sdk-go/service/codebuild"
 	codecommit_sdkv1 "github.com/aws/aws-sdk-go/service/codecommit"
 	codedeploy_sdkv1 "github.com/aws/aws-sdk-go/service/codedeploy"
+	codeguruprofiler_sdkv1 "github.com/aws/aws-sdk-go/service/codeguruprofiler"
 	codegurureviewer_sdkv1 "github.com/aws/aws-sdk-go/service/codegurureviewer"
 	codepipeline_sdkv1 "github.com/aws/aws-sdk-go/service/codepipeline"
 	codestarconnections_sdkv1 "github.com/aws/aws-sdk-go/service/codestarconnections"
@@ -113,6 +114,7 @@ import (
 	dax_sdkv1 "github.com/aws/aws-sdk-go/service/dax"
 	detective_sdkv1 "github.com/aws/aws-sdk-go/service/detective"
 	devicefarm_sdkv1 "github.com/aws/aws-sdk-go/service/devicefarm"
+	devopsguru_sdkv1 "github.com/aws/aws-sdk-go/service/devopsguru"
 	directconnect_sdkv1 "github.com/aws/aws-sdk-go/service/directconnect"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
 	dlm_sdkv1 "github.com/aws/aws-sdk-go/service/dlm"
@@ -135,6 +137,7 @@ import (
 	eventbridge_sdkv1 "github.com/aws/aws-sdk-go/service/eventbridge"
 	firehose_sdkv1 "github.com/aws/aws-sdk-go/service/firehose"
 	fms_sdkv1 "github.com/aws/aws-sdk-go/service/fms"
+	frauddetector_sdkv1 "github.com/aws/aws-sdk-go/service/frauddetector"
 	fsx_sdkv1 "github.com/aws/aws-sdk-go/service/fsx"
 	gamelift_sdkv1 "github.com/aws/aws-sdk-go/service/gamelift"
 	globalaccelerator_sdkv1 "github.com/aws/aws-sdk-go/service/globalaccelerator"
@@ -161,6 +164,8 @@ import (
 	licensemanager_sdkv1 "github.com/aws/aws-sdk-go/service/licensemanager"
 	locationservice_sdkv1 "github.com/aws/aws-sdk-go/service/locationservice"
 	macie2_sdkv1 "github.com/aws/aws-sdk-go/service/macie2"
+	managedblockchain_sdkv1 "github.com/aws/aws-sdk-go/service/managedblockchain"
+	managedblockchainquery_sdkv1 "github.com/aws/aws-sdk-go/service/managedblockchainquery"
 	managedgrafana_sdkv1 "github.com/aws/aws-sdk-go/service/managedgrafana"
 	mediaconnect_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconnect"
 	mediaconvert_sdkv1 "github.com/aws/aws-sdk-go/service/mediaconvert"
@@ -176,6 +181,7 @@ import (
 	organizations_sdkv1 "github.com/aws/aws-sdk-go/service/organizations"
 	outposts_sdkv1 "github.com/aws/aws-sdk-go/service/outposts"
 	pinpoint_sdkv1 "github.com/aws/aws-sdk-go/service/pinpoint"
+	polly_sdkv1 "github.com/aws/aws-sdk-go/service/polly"
 	prometheusservice_sdkv1 "github.com/aws/aws-sdk-go/service/prometheusservice"
 	quicksight_sdkv1 "github.com/aws/aws-sdk-go/service/quicksight"
 	ram_sdkv1 "github.com/aws/aws-sdk-go/service/ram"
@@ -183,6 +189,7 @@ import (
 	redshift_sdkv1 "github.com/aws/aws-sdk-go/service/redshift"
 	redshiftdataapiservice_sdkv1 "github.com/aws/aws-sdk-go/service/redshiftdataapiservice"
 	redshiftserverless_sdkv1 "github.com/aws/aws-sdk-go/service/redshiftserverless"
+	rekognition_sdkv1 "github.com/aws/aws-sdk-go/service/rekognition"
 	resourcegroups_sdkv1 "github.com/aws/aws-sdk-go/service/resourcegroups"
 	resourcegroupstaggingapi_sdkv1 "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	route53_sdkv1 "github.com/aws/aws-sdk-go/service/route53"
@@ -212,6 +219,7 @@ import (
 	sts_sdkv1 "github.com/aws/aws-sdk-go/service/sts"
 	synthetics_sdkv1 "github.com/aws/aws-sdk-go/service/synthetics"
 	transfer_sdkv1 "github.com/aws/aws-sdk-go/service/transfer"
+	translate_sdkv1 "github.com/aws/aws-sdk-go/service/translate"
 	waf_sdkv1 "github.com/aws/aws-sdk-go/service/waf"
 	wafregional_sdkv1 "github.com/aws/aws-sdk-go/service/wafregional"
 	wafv2_sdkv1 "github.com/aws/aws-sdk-go/service/wafv2"
@@ -392,6 +400,10 @@ func (c *AWSClient) CodeCommitConn(ctx context.Context) *codecommit_sdkv1.CodeCo
 	return errs.Must(conn[*codecommit_sdkv1.CodeCommit](ctx, c, names.CodeCommit))
 }
 
+func (c *AWSClient) CodeGuruProfilerConn(ctx context.Context) *codeguruprofiler_sdkv1.CodeGuruProfiler {
+	return errs.Must(conn[*codeguruprofiler_sdkv1.CodeGuruProfiler](ctx, c, names.CodeGuruProfiler))
+}
+
 func (c *AWSClient) CodeGuruReviewerConn(ctx context.Context) *codegurureviewer_sdkv1.CodeGuruReviewer {
 	return errs.Must(conn[*codegurureviewer_sdkv1.CodeGuruReviewer](ctx, c, names.CodeGuruReviewer))
 }
@@ -476,6 +488,10 @@ func (c *AWSClient) DetectiveConn(ctx context.Context) *detective_sdkv1.Detectiv
 	return errs.Must(conn[*detective_sdkv1.Detective](ctx, c, names.Detective))
 }
 
+func (c *AWSClient) DevOpsGuruConn(ctx context.Context) *devopsguru_sdkv1.DevOpsGuru {
+	return errs.Must(conn[*devopsguru_sdkv1.DevOpsGuru](ctx, c, names.DevOpsGuru))
+}
+
 func (c *AWSClient) DeviceFarmConn(ctx context.Context) *devicefarm_sdkv1.DeviceFarm {
 	return errs.Must(conn[*devicefarm_sdkv1.DeviceFarm](ctx, c, names.DeviceFarm))
 }
@@ -576,6 +592,10 @@ func (c *AWSClient) FMSConn(ctx context.Context) *fms_sdkv1.FMS {
 	return errs.Must(conn[*fms_sdkv1.FMS](ctx, c, names.FMS))
 }
 
+func (c *AWSClient) FraudDetectorConn(ctx context.Context) *frauddetector_sdkv1.FraudDetector {
+	return errs.Must(conn[*frauddetector_sdkv1.FraudDetector](ctx, c, names.FraudDetector))
+}
+
 func (c *AWSClient) FSxConn(ctx context.Context) *fsx_sdkv1.FSx {
 	return errs.Must(conn[*fsx_sdkv1.FSx](ctx, c, names.FSx))
 }
@@ -752,6 +772,14 @@ func (c *AWSClient) Macie2Conn(ctx context.Context) *macie2_sdkv1.Macie2 {
 	return errs.Must(conn[*macie2_sdkv1.Macie2](ctx, c, names.Macie2))
 }
 
+func (c *AWSClient) ManagedBlockchainConn(ctx context.Context) *managedblockchain_sdkv1.ManagedBlockchain {
+	return errs.Must(conn[*managedblockchain_sdkv1.ManagedBlockchain](ctx, c, names.ManagedBlockchain))
+}
+
+func (c *AWSClient) ManagedBlockchainQueryConn(ctx context.Context) *managedblockchainquery_sdkv1.ManagedBlockchainQuery {
+	return errs.Must(conn[*managedblockchainquery_sdkv1.ManagedBlockchainQuery](ctx, c, names.ManagedBlockchainQuery))
+}
+
 func (c *AWSClient) MediaConnectConn(ctx context.Context) *mediaconnect_sdkv1.MediaConnect {
 	return errs.Must(conn[*mediaconnect_sdkv1.MediaConnect](ctx, c, names.MediaConnect))
 }
@@ -820,6 +848,10 @@ func (c *AWSClient) PipesClient(ctx context.Context) *pipes_sdkv2.Client {
 	return errs.Must(client[*pipes_sdkv2.Client](ctx, c, names.Pipes))
 }
 
+func (c *AWSClient) PollyConn(ctx context.Context) *polly_sdkv1.Polly {
+	return errs.Must(conn[*polly_sdkv1.Polly](ctx, c, names.Polly))
+}
+
 func (c *AWSClient) PricingClient(ctx context.Context) *pricing_sdkv2.Client {
 	return errs.Must(client[*pricing_sdkv2.Client](ctx, c, names.Pricing))
 }
@@ -864,6 +896,10 @@ func (c *AWSClient) RedshiftServerlessConn(ctx context.Context) *redshiftserverl
 	return errs.Must(conn[*redshiftserverless_sdkv1.RedshiftServerless](ctx, c, names.RedshiftServerless))
 }
 
+func (c *AWSClient) RekognitionConn(ctx context.Context) *rekognition_sdkv1.Rekognition {
+	return errs.Must(conn[*rekognition_sdkv1.Rekognition](ctx, c, names.Rekognition))
+}
+
 func (c *AWSClient) ResourceExplorer2Client(ctx context.Context) *resourceexplorer2_sdkv2.Client {
 	return errs.Must(client[*resourceexplorer2_sdkv2.Client](ctx, c, names.ResourceExplorer2))
 }
@@ -1036,6 +1072,10 @@ func (c *AWSClient) TransferConn(ctx context.Context) *transfer_sdkv1.Transfer {
 	return errs.Must(conn[*transfer_sdkv1.Transfer](ctx, c, names.Transfer))
 }
 
+func (c *AWSClient) TranslateConn(ctx context.Context) *translate_sdkv1.Translate {
+	return errs.Must(conn[*translate_sdkv1.Translate](ctx, c, names.Translate))
+}
+
 func (c *AWSClient) VPCLatticeClient(ctx context.Context) *vpclattice_sdkv2.Client {
 	return errs.Must(client[*vpclattice_sdkv2.Client](ctx, c, names.VPCLattice))
 }
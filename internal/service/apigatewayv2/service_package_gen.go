@@ -106,6 +106,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceWebSocketRoutes,
+			TypeName: "aws_apigatewayv2_websocket_routes",
+			Name:     "WebSocket Routes",
+		},
 	}
 }
 
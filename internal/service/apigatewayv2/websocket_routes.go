@@ -0,0 +1,889 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	webSocketIntegrationTargetPrefix = "integrations/"
+)
+
+// @SDKResource("aws_apigatewayv2_websocket_routes", name="WebSocket Routes")
+func ResourceWebSocketRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebSocketRoutesCreate,
+		ReadWithoutTimeout:   resourceWebSocketRoutesRead,
+		UpdateWithoutTimeout: resourceWebSocketRoutesUpdate,
+		DeleteWithoutTimeout: resourceWebSocketRoutesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.ComputedIf("route_ids", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+			return diff.HasChange("route")
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key_required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"authorization_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      apigatewayv2.AuthorizationTypeNone,
+							ValidateFunc: validation.StringInSlice([]string{apigatewayv2.AuthorizationTypeNone, apigatewayv2.AuthorizationTypeAwsIam, apigatewayv2.AuthorizationTypeCustom}, false),
+						},
+						"authorizer_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"integration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content_handling_strategy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(apigatewayv2.ContentHandlingStrategy_Values(), false),
+									},
+									"credentials_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"integration_method": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validHTTPMethod(),
+									},
+									"integration_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(apigatewayv2.IntegrationType_Values(), false),
+									},
+									"integration_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"passthrough_behavior": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      apigatewayv2.PassthroughBehaviorWhenNoMatch,
+										ValidateFunc: validation.StringInSlice(apigatewayv2.PassthroughBehavior_Values(), false),
+									},
+									"request_templates": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"template_selection_expression": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"timeout_milliseconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      29000,
+										ValidateFunc: validation.IntBetween(50, 29000),
+									},
+								},
+							},
+						},
+						"integration_response": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content_handling_strategy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(apigatewayv2.ContentHandlingStrategy_Values(), false),
+									},
+									"integration_response_key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"response_templates": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"template_selection_expression": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"operation_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"route_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"route_response": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"model_selection_expression": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"response_models": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"route_response_key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"route_response_selection_expression": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"route_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceWebSocketRoutesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn(ctx)
+
+	apiID := d.Get("api_id").(string)
+	api, err := FindAPIByID(ctx, conn, apiID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 API (%s): %s", apiID, err)
+	}
+
+	if v := aws.StringValue(api.ProtocolType); v != apigatewayv2.ProtocolTypeWebsocket {
+		return sdkdiag.AppendErrorf(diags, "API Gateway v2 API (%s) protocol type is %s, must be %s", apiID, v, apigatewayv2.ProtocolTypeWebsocket)
+	}
+
+	// Set the ID and the IDs of the routes as they're created so that, if creation fails,
+	// the routes created so far are deleted when the tainted resource is replaced.
+	d.SetId(apiID)
+	routeIDs := make(map[string]interface{})
+
+	for _, tfMapRaw := range d.Get("route").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		routeID, err := createWebSocketRoute(ctx, conn, apiID, tfMap)
+
+		if routeID != "" {
+			routeIDs[tfMap["route_key"].(string)] = routeID
+		}
+
+		if err != nil {
+			d.Set("route_ids", routeIDs)
+			return sdkdiag.AppendErrorf(diags, "creating API Gateway v2 WebSocket Routes (%s): %s", apiID, err)
+		}
+	}
+
+	return append(diags, resourceWebSocketRoutesRead(ctx, d, meta)...)
+}
+
+func resourceWebSocketRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn(ctx)
+
+	if _, err := FindAPIByID(ctx, conn, d.Id()); !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway v2 API (%s) not found, removing WebSocket Routes from state", d.Id())
+		d.SetId("")
+		return diags
+	} else if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 WebSocket Routes (%s): %s", d.Id(), err)
+	}
+
+	// Only routes managed by this resource are read, so that routes managed elsewhere
+	// (e.g. by aws_apigatewayv2_route) are neither reported nor deleted.
+	// On import there are no managed routes yet, so all the API's routes are adopted.
+	priorRoutes := webSocketRoutesByKey(d.Get("route").(*schema.Set).List())
+	managedRouteKeys := make(map[string]bool)
+	for k := range priorRoutes {
+		managedRouteKeys[k] = true
+	}
+	for k := range d.Get("route_ids").(map[string]interface{}) {
+		managedRouteKeys[k] = true
+	}
+
+	routes, err := findRoutes(ctx, conn, &apigatewayv2.GetRoutesInput{
+		ApiId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 WebSocket Routes (%s): %s", d.Id(), err)
+	}
+
+	integrations, err := findIntegrations(ctx, conn, &apigatewayv2.GetIntegrationsInput{
+		ApiId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 WebSocket Routes (%s): %s", d.Id(), err)
+	}
+
+	integrationsByID := make(map[string]*apigatewayv2.Integration, len(integrations))
+	for _, v := range integrations {
+		integrationsByID[aws.StringValue(v.IntegrationId)] = v
+	}
+
+	var tfList []interface{}
+	routeIDs := make(map[string]interface{}, len(routes))
+
+	for _, route := range routes {
+		routeID, routeKey := aws.StringValue(route.RouteId), aws.StringValue(route.RouteKey)
+
+		if len(managedRouteKeys) > 0 && !managedRouteKeys[routeKey] {
+			continue
+		}
+
+		tfMap := flattenWebSocketRoute(route)
+
+		// Responses are only used by two-way routes. To avoid extra requests for each one-way route,
+		// their responses are only read if they were previously configured.
+		twoWay := aws.StringValue(route.RouteResponseSelectionExpression) != ""
+		priorRoute := priorRoutes[routeKey]
+
+		if twoWay || (priorRoute != nil && len(priorRoute["route_response"].([]interface{})) > 0) {
+			routeResponses, err := findRouteResponses(ctx, conn, &apigatewayv2.GetRouteResponsesInput{
+				ApiId:   aws.String(d.Id()),
+				RouteId: aws.String(routeID),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 route (%s) responses: %s", routeID, err)
+			}
+
+			if len(routeResponses) > 0 {
+				tfMap["route_response"] = []interface{}{flattenWebSocketRouteResponse(routeResponses[0])}
+			}
+		}
+
+		if integration, ok := integrationsByID[webSocketIntegrationIDFromTarget(route.Target)]; ok {
+			integrationID := aws.StringValue(integration.IntegrationId)
+			tfMap["integration"] = []interface{}{flattenWebSocketIntegration(integration)}
+
+			if twoWay || (priorRoute != nil && priorRoute["integration_response"].(*schema.Set).Len() > 0) {
+				integrationResponses, err := findIntegrationResponses(ctx, conn, &apigatewayv2.GetIntegrationResponsesInput{
+					ApiId:         aws.String(d.Id()),
+					IntegrationId: aws.String(integrationID),
+				})
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 integration (%s) responses: %s", integrationID, err)
+				}
+
+				tfMap["integration_response"] = flattenWebSocketIntegrationResponses(integrationResponses)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+		routeIDs[routeKey] = routeID
+	}
+
+	d.Set("api_id", d.Id())
+	if err := d.Set("route", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+	}
+	d.Set("route_ids", routeIDs)
+
+	return diags
+}
+
+func resourceWebSocketRoutesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn(ctx)
+
+	o, n := d.GetChange("route")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	oldRoutes, newRoutes := webSocketRoutesByKey(os.List()), webSocketRoutesByKey(ns.List())
+	routeIDs := make(map[string]interface{})
+	for k, v := range d.Get("route_ids").(map[string]interface{}) {
+		routeIDs[k] = v
+	}
+
+	for routeKey := range oldRoutes {
+		if _, ok := newRoutes[routeKey]; ok {
+			continue
+		}
+
+		routeID, _ := routeIDs[routeKey].(string)
+
+		if err := deleteWebSocketRoute(ctx, conn, d.Id(), routeID); err != nil {
+			d.Set("route_ids", routeIDs)
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway v2 WebSocket Routes (%s): %s", d.Id(), err)
+		}
+
+		delete(routeIDs, routeKey)
+	}
+
+	for routeKey, tfMap := range newRoutes {
+		var err error
+
+		if _, ok := oldRoutes[routeKey]; !ok {
+			var routeID string
+			routeID, err = createWebSocketRoute(ctx, conn, d.Id(), tfMap)
+
+			if routeID != "" {
+				routeIDs[routeKey] = routeID
+			}
+		} else if !os.Contains(tfMap) {
+			// An unchanged route is still in the old set. Routes are compared by hash, as
+			// their nested integration_response sets never compare equal by value.
+			routeID, _ := routeIDs[routeKey].(string)
+			err = updateWebSocketRoute(ctx, conn, d.Id(), routeID, tfMap)
+		}
+
+		if err != nil {
+			d.Set("route_ids", routeIDs)
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway v2 WebSocket Routes (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceWebSocketRoutesRead(ctx, d, meta)...)
+}
+
+func resourceWebSocketRoutesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting API Gateway v2 WebSocket Routes: %s", d.Id())
+	for _, v := range d.Get("route_ids").(map[string]interface{}) {
+		if err := deleteWebSocketRoute(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting API Gateway v2 WebSocket Routes (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// createWebSocketRoute creates a route and its integration, along with their responses.
+// The ID of the route is returned if it was created, even if creating its responses failed.
+// If the route can't be created, its integration is deleted.
+func createWebSocketRoute(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID string, tfMap map[string]interface{}) (string, error) {
+	routeKey := tfMap["route_key"].(string)
+
+	integrationInput := &apigatewayv2.CreateIntegrationInput{
+		ApiId: aws.String(apiID),
+	}
+	expandWebSocketIntegration(tfMap["integration"].([]interface{})[0].(map[string]interface{}), integrationInput)
+
+	integration, err := conn.CreateIntegrationWithContext(ctx, integrationInput)
+
+	if err != nil {
+		return "", fmt.Errorf("creating integration for route (%s): %w", routeKey, err)
+	}
+
+	integrationID := aws.StringValue(integration.IntegrationId)
+
+	if err := createWebSocketIntegrationResponses(ctx, conn, apiID, integrationID, tfMap["integration_response"].(*schema.Set).List()); err != nil {
+		return "", errors.Join(fmt.Errorf("route (%s): %w", routeKey, err), deleteWebSocketIntegration(ctx, conn, apiID, integrationID))
+	}
+
+	routeInput := &apigatewayv2.CreateRouteInput{
+		ApiId:             aws.String(apiID),
+		ApiKeyRequired:    aws.Bool(tfMap["api_key_required"].(bool)),
+		AuthorizationType: aws.String(tfMap["authorization_type"].(string)),
+		RouteKey:          aws.String(routeKey),
+		Target:            aws.String(webSocketIntegrationTargetPrefix + integrationID),
+	}
+	if v, ok := tfMap["authorizer_id"].(string); ok && v != "" {
+		routeInput.AuthorizerId = aws.String(v)
+	}
+	if v, ok := tfMap["operation_name"].(string); ok && v != "" {
+		routeInput.OperationName = aws.String(v)
+	}
+	if v, ok := tfMap["route_response_selection_expression"].(string); ok && v != "" {
+		routeInput.RouteResponseSelectionExpression = aws.String(v)
+	}
+
+	route, err := conn.CreateRouteWithContext(ctx, routeInput)
+
+	if err != nil {
+		return "", errors.Join(fmt.Errorf("creating route (%s): %w", routeKey, err), deleteWebSocketIntegration(ctx, conn, apiID, integrationID))
+	}
+
+	routeID := aws.StringValue(route.RouteId)
+
+	if err := createWebSocketRouteResponse(ctx, conn, apiID, routeID, tfMap["route_response"].([]interface{})); err != nil {
+		return routeID, fmt.Errorf("route (%s): %w", routeKey, err)
+	}
+
+	return routeID, nil
+}
+
+func updateWebSocketRoute(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID, routeID string, tfMap map[string]interface{}) error {
+	routeKey := tfMap["route_key"].(string)
+
+	route, err := conn.GetRouteWithContext(ctx, &apigatewayv2.GetRouteInput{
+		ApiId:   aws.String(apiID),
+		RouteId: aws.String(routeID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading route (%s): %w", routeKey, err)
+	}
+
+	integrationID := webSocketIntegrationIDFromTarget(route.Target)
+	createInput := &apigatewayv2.CreateIntegrationInput{}
+	expandWebSocketIntegration(tfMap["integration"].([]interface{})[0].(map[string]interface{}), createInput)
+
+	integrationInput := &apigatewayv2.UpdateIntegrationInput{
+		ApiId:                       aws.String(apiID),
+		ContentHandlingStrategy:     createInput.ContentHandlingStrategy,
+		CredentialsArn:              aws.String(aws.StringValue(createInput.CredentialsArn)),
+		IntegrationId:               aws.String(integrationID),
+		IntegrationMethod:           createInput.IntegrationMethod,
+		IntegrationType:             createInput.IntegrationType,
+		IntegrationUri:              createInput.IntegrationUri,
+		PassthroughBehavior:         createInput.PassthroughBehavior,
+		RequestTemplates:            createInput.RequestTemplates,
+		TemplateSelectionExpression: aws.String(aws.StringValue(createInput.TemplateSelectionExpression)),
+		TimeoutInMillis:             createInput.TimeoutInMillis,
+	}
+
+	if _, err := conn.UpdateIntegrationWithContext(ctx, integrationInput); err != nil {
+		return fmt.Errorf("updating integration for route (%s): %w", routeKey, err)
+	}
+
+	// Integration and route responses are replaced rather than reconciled individually.
+	if err := deleteWebSocketIntegrationResponses(ctx, conn, apiID, integrationID); err != nil {
+		return fmt.Errorf("route (%s): %w", routeKey, err)
+	}
+
+	if err := createWebSocketIntegrationResponses(ctx, conn, apiID, integrationID, tfMap["integration_response"].(*schema.Set).List()); err != nil {
+		return fmt.Errorf("route (%s): %w", routeKey, err)
+	}
+
+	routeInput := &apigatewayv2.UpdateRouteInput{
+		ApiId:                            aws.String(apiID),
+		ApiKeyRequired:                   aws.Bool(tfMap["api_key_required"].(bool)),
+		AuthorizationType:                aws.String(tfMap["authorization_type"].(string)),
+		AuthorizerId:                     aws.String(tfMap["authorizer_id"].(string)),
+		OperationName:                    aws.String(tfMap["operation_name"].(string)),
+		RouteId:                          aws.String(routeID),
+		RouteResponseSelectionExpression: aws.String(tfMap["route_response_selection_expression"].(string)),
+	}
+
+	if _, err := conn.UpdateRouteWithContext(ctx, routeInput); err != nil {
+		return fmt.Errorf("updating route (%s): %w", routeKey, err)
+	}
+
+	if err := deleteWebSocketRouteResponses(ctx, conn, apiID, routeID); err != nil {
+		return fmt.Errorf("route (%s): %w", routeKey, err)
+	}
+
+	if err := createWebSocketRouteResponse(ctx, conn, apiID, routeID, tfMap["route_response"].([]interface{})); err != nil {
+		return fmt.Errorf("route (%s): %w", routeKey, err)
+	}
+
+	return nil
+}
+
+// deleteWebSocketRoute deletes the specified route and its integration, along with their responses.
+func deleteWebSocketRoute(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID, routeID string) error {
+	route, err := conn.GetRouteWithContext(ctx, &apigatewayv2.GetRouteInput{
+		ApiId:   aws.String(apiID),
+		RouteId: aws.String(routeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading route (%s): %w", routeID, err)
+	}
+
+	if err := deleteWebSocketRouteResponses(ctx, conn, apiID, routeID); err != nil {
+		return err
+	}
+
+	_, err = conn.DeleteRouteWithContext(ctx, &apigatewayv2.DeleteRouteInput{
+		ApiId:   aws.String(apiID),
+		RouteId: aws.String(routeID),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return fmt.Errorf("deleting route (%s): %w", routeID, err)
+	}
+
+	integrationID := webSocketIntegrationIDFromTarget(route.Target)
+
+	if integrationID == "" {
+		return nil
+	}
+
+	return deleteWebSocketIntegration(ctx, conn, apiID, integrationID)
+}
+
+// deleteWebSocketIntegration deletes the specified integration and its responses.
+func deleteWebSocketIntegration(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID, integrationID string) error {
+	if err := deleteWebSocketIntegrationResponses(ctx, conn, apiID, integrationID); err != nil {
+		return err
+	}
+
+	_, err := conn.DeleteIntegrationWithContext(ctx, &apigatewayv2.DeleteIntegrationInput{
+		ApiId:         aws.String(apiID),
+		IntegrationId: aws.String(integrationID),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return fmt.Errorf("deleting integration (%s): %w", integrationID, err)
+	}
+
+	return nil
+}
+
+func createWebSocketIntegrationResponses(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID, integrationID string, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		input := &apigatewayv2.CreateIntegrationResponseInput{
+			ApiId:                  aws.String(apiID),
+			IntegrationId:          aws.String(integrationID),
+			IntegrationResponseKey: aws.String(tfMap["integration_response_key"].(string)),
+		}
+		if v, ok := tfMap["content_handling_strategy"].(string); ok && v != "" {
+			input.ContentHandlingStrategy = aws.String(v)
+		}
+		if v, ok := tfMap["response_templates"].(map[string]interface{}); ok && len(v) > 0 {
+			input.ResponseTemplates = flex.ExpandStringMap(v)
+		}
+		if v, ok := tfMap["template_selection_expression"].(string); ok && v != "" {
+			input.TemplateSelectionExpression = aws.String(v)
+		}
+
+		if _, err := conn.CreateIntegrationResponseWithContext(ctx, input); err != nil {
+			return fmt.Errorf("creating integration (%s) response: %w", integrationID, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteWebSocketIntegrationResponses(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID, integrationID string) error {
+	integrationResponses, err := findIntegrationResponses(ctx, conn, &apigatewayv2.GetIntegrationResponsesInput{
+		ApiId:         aws.String(apiID),
+		IntegrationId: aws.String(integrationID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading integration (%s) responses: %w", integrationID, err)
+	}
+
+	for _, v := range integrationResponses {
+		_, err := conn.DeleteIntegrationResponseWithContext(ctx, &apigatewayv2.DeleteIntegrationResponseInput{
+			ApiId:                 aws.String(apiID),
+			IntegrationId:         aws.String(integrationID),
+			IntegrationResponseId: v.IntegrationResponseId,
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+			return fmt.Errorf("deleting integration (%s) response: %w", integrationID, err)
+		}
+	}
+
+	return nil
+}
+
+func createWebSocketRouteResponse(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID, routeID string, tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	input := &apigatewayv2.CreateRouteResponseInput{
+		ApiId:            aws.String(apiID),
+		RouteId:          aws.String(routeID),
+		RouteResponseKey: aws.String(tfMap["route_response_key"].(string)),
+	}
+	if v, ok := tfMap["model_selection_expression"].(string); ok && v != "" {
+		input.ModelSelectionExpression = aws.String(v)
+	}
+	if v, ok := tfMap["response_models"].(map[string]interface{}); ok && len(v) > 0 {
+		input.ResponseModels = flex.ExpandStringMap(v)
+	}
+
+	if _, err := conn.CreateRouteResponseWithContext(ctx, input); err != nil {
+		return fmt.Errorf("creating route (%s) response: %w", routeID, err)
+	}
+
+	return nil
+}
+
+func deleteWebSocketRouteResponses(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, apiID, routeID string) error {
+	routeResponses, err := findRouteResponses(ctx, conn, &apigatewayv2.GetRouteResponsesInput{
+		ApiId:   aws.String(apiID),
+		RouteId: aws.String(routeID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading route (%s) responses: %w", routeID, err)
+	}
+
+	for _, v := range routeResponses {
+		_, err := conn.DeleteRouteResponseWithContext(ctx, &apigatewayv2.DeleteRouteResponseInput{
+			ApiId:           aws.String(apiID),
+			RouteId:         aws.String(routeID),
+			RouteResponseId: v.RouteResponseId,
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+			return fmt.Errorf("deleting route (%s) response: %w", routeID, err)
+		}
+	}
+
+	return nil
+}
+
+func findRoutes(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRoutesInput) ([]*apigatewayv2.Route, error) {
+	var output []*apigatewayv2.Route
+
+	err := getRoutesPages(ctx, conn, input, func(page *apigatewayv2.GetRoutesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findIntegrations(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetIntegrationsInput) ([]*apigatewayv2.Integration, error) {
+	var output []*apigatewayv2.Integration
+
+	err := getIntegrationsPages(ctx, conn, input, func(page *apigatewayv2.GetIntegrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findRouteResponses(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetRouteResponsesInput) ([]*apigatewayv2.RouteResponse, error) {
+	var output []*apigatewayv2.RouteResponse
+
+	err := getRouteResponsesPages(ctx, conn, input, func(page *apigatewayv2.GetRouteResponsesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findIntegrationResponses(ctx context.Context, conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetIntegrationResponsesInput) ([]*apigatewayv2.IntegrationResponse, error) {
+	var output []*apigatewayv2.IntegrationResponse
+
+	err := getIntegrationResponsesPages(ctx, conn, input, func(page *apigatewayv2.GetIntegrationResponsesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func webSocketRoutesByKey(tfList []interface{}) map[string]map[string]interface{} {
+	routes := make(map[string]map[string]interface{}, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			routes[tfMap["route_key"].(string)] = tfMap
+		}
+	}
+
+	return routes
+}
+
+func webSocketIntegrationIDFromTarget(target *string) string {
+	if v := aws.StringValue(target); strings.HasPrefix(v, webSocketIntegrationTargetPrefix) {
+		return strings.TrimPrefix(v, webSocketIntegrationTargetPrefix)
+	}
+
+	return ""
+}
+
+func expandWebSocketIntegration(tfMap map[string]interface{}, apiObject *apigatewayv2.CreateIntegrationInput) {
+	apiObject.IntegrationType = aws.String(tfMap["integration_type"].(string))
+
+	if v, ok := tfMap["content_handling_strategy"].(string); ok && v != "" {
+		apiObject.ContentHandlingStrategy = aws.String(v)
+	}
+	if v, ok := tfMap["credentials_arn"].(string); ok && v != "" {
+		apiObject.CredentialsArn = aws.String(v)
+	}
+	if v, ok := tfMap["integration_method"].(string); ok && v != "" {
+		apiObject.IntegrationMethod = aws.String(v)
+	}
+	if v, ok := tfMap["integration_uri"].(string); ok && v != "" {
+		apiObject.IntegrationUri = aws.String(v)
+	}
+	if v, ok := tfMap["passthrough_behavior"].(string); ok && v != "" {
+		apiObject.PassthroughBehavior = aws.String(v)
+	}
+	if v, ok := tfMap["request_templates"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.RequestTemplates = flex.ExpandStringMap(v)
+	}
+	if v, ok := tfMap["template_selection_expression"].(string); ok && v != "" {
+		apiObject.TemplateSelectionExpression = aws.String(v)
+	}
+	if v, ok := tfMap["timeout_milliseconds"].(int); ok && v != 0 {
+		apiObject.TimeoutInMillis = aws.Int64(int64(v))
+	}
+}
+
+func flattenWebSocketRoute(apiObject *apigatewayv2.Route) map[string]interface{} {
+	return map[string]interface{}{
+		"api_key_required":                    aws.BoolValue(apiObject.ApiKeyRequired),
+		"authorization_type":                  aws.StringValue(apiObject.AuthorizationType),
+		"authorizer_id":                       aws.StringValue(apiObject.AuthorizerId),
+		"operation_name":                      aws.StringValue(apiObject.OperationName),
+		"route_key":                           aws.StringValue(apiObject.RouteKey),
+		"route_response_selection_expression": aws.StringValue(apiObject.RouteResponseSelectionExpression),
+	}
+}
+
+func flattenWebSocketIntegration(apiObject *apigatewayv2.Integration) map[string]interface{} {
+	return map[string]interface{}{
+		"content_handling_strategy":     aws.StringValue(apiObject.ContentHandlingStrategy),
+		"credentials_arn":               aws.StringValue(apiObject.CredentialsArn),
+		"integration_method":            aws.StringValue(apiObject.IntegrationMethod),
+		"integration_type":              aws.StringValue(apiObject.IntegrationType),
+		"integration_uri":               aws.StringValue(apiObject.IntegrationUri),
+		"passthrough_behavior":          aws.StringValue(apiObject.PassthroughBehavior),
+		"request_templates":             aws.StringValueMap(apiObject.RequestTemplates),
+		"template_selection_expression": aws.StringValue(apiObject.TemplateSelectionExpression),
+		"timeout_milliseconds":          int(aws.Int64Value(apiObject.TimeoutInMillis)),
+	}
+}
+
+func flattenWebSocketIntegrationResponses(apiObjects []*apigatewayv2.IntegrationResponse) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"content_handling_strategy":     aws.StringValue(apiObject.ContentHandlingStrategy),
+			"integration_response_key":      aws.StringValue(apiObject.IntegrationResponseKey),
+			"response_templates":            aws.StringValueMap(apiObject.ResponseTemplates),
+			"template_selection_expression": aws.StringValue(apiObject.TemplateSelectionExpression),
+		})
+	}
+
+	return tfList
+}
+
+func flattenWebSocketRouteResponse(apiObject *apigatewayv2.RouteResponse) map[string]interface{} {
+	return map[string]interface{}{
+		"model_selection_expression": aws.StringValue(apiObject.ModelSelectionExpression),
+		"response_models":            aws.StringValueMap(apiObject.ResponseModels),
+		"route_response_key":         aws.StringValue(apiObject.RouteResponseKey),
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigatewayv2_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccAPIGatewayV2WebSocketRoutes_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_apigatewayv2_websocket_routes.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebSocketRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebSocketRoutesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebSocketRoutesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "api_id", "aws_apigatewayv2_api.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "route_ids.%", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"route_key":                           "$default",
+						"route_response_selection_expression": "$default",
+						"integration.#":                       "1",
+						"integration.0.integration_type":      apigatewayv2.IntegrationTypeMock,
+						"integration_response.#":              "1",
+						"route_response.#":                    "1",
+						"route_response.0.route_response_key": "$default",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"route_key":              "$connect",
+						"integration.#":          "1",
+						"integration_response.#": "0",
+						"route_response.#":       "0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebSocketRoutesConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebSocketRoutesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "route_ids.%", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"route_key":      "$default",
+						"operation_name": "Default",
+						"integration.0.template_selection_expression": "201",
+						"integration_response.#":                      "1",
+						"route_response.#":                            "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"route_key":     "$disconnect",
+						"integration.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayV2WebSocketRoutes_updateOtherRoute(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after []string
+	resourceName := "aws_apigatewayv2_websocket_routes.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebSocketRoutesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebSocketRoutesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebSocketRoutesExists(ctx, resourceName, 2),
+					testAccCheckWebSocketRouteResponseIDs(ctx, resourceName, "$default", &before),
+				),
+			},
+			{
+				// Changing the $connect route must not replace the $default route's responses.
+				Config: testAccWebSocketRoutesConfig_connectOperationName(rName, "Connect"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebSocketRoutesExists(ctx, resourceName, 2),
+					testAccCheckWebSocketRouteResponseIDs(ctx, resourceName, "$default", &after),
+					testAccCheckWebSocketRouteResponsesNotRecreated(&before, &after),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"route_key":      "$connect",
+						"operation_name": "Connect",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWebSocketRoutesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_apigatewayv2_websocket_routes" {
+				continue
+			}
+
+			output, err := conn.GetRoutesWithContext(ctx, &apigatewayv2.GetRoutesInput{
+				ApiId: aws.String(rs.Primary.ID),
+			})
+			if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(output.Items) > 0 {
+				return fmt.Errorf("API Gateway v2 WebSocket Routes %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWebSocketRoutesExists(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 WebSocket Routes ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn(ctx)
+
+		output, err := conn.GetRoutesWithContext(ctx, &apigatewayv2.GetRoutesInput{
+			ApiId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.Items); got != count {
+			return fmt.Errorf("API Gateway v2 WebSocket Routes %s: got %d routes, want %d", rs.Primary.ID, got, count)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckWebSocketRouteResponseIDs records the IDs of the integration and route responses of the specified route.
+func testAccCheckWebSocketRouteResponseIDs(ctx context.Context, n, routeKey string, v *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		routeID := rs.Primary.Attributes["route_ids."+routeKey]
+		if routeID == "" {
+			return fmt.Errorf("No API Gateway v2 WebSocket Route ID is set for %s", routeKey)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn(ctx)
+
+		route, err := conn.GetRouteWithContext(ctx, &apigatewayv2.GetRouteInput{
+			ApiId:   aws.String(rs.Primary.ID),
+			RouteId: aws.String(routeID),
+		})
+		if err != nil {
+			return err
+		}
+
+		integrationResponses, err := conn.GetIntegrationResponsesWithContext(ctx, &apigatewayv2.GetIntegrationResponsesInput{
+			ApiId:         aws.String(rs.Primary.ID),
+			IntegrationId: aws.String(strings.TrimPrefix(aws.StringValue(route.Target), "integrations/")),
+		})
+		if err != nil {
+			return err
+		}
+
+		routeResponses, err := conn.GetRouteResponsesWithContext(ctx, &apigatewayv2.GetRouteResponsesInput{
+			ApiId:   aws.String(rs.Primary.ID),
+			RouteId: aws.String(routeID),
+		})
+		if err != nil {
+			return err
+		}
+
+		var ids []string
+		for _, v := range integrationResponses.Items {
+			ids = append(ids, aws.StringValue(v.IntegrationResponseId))
+		}
+		for _, v := range routeResponses.Items {
+			ids = append(ids, aws.StringValue(v.RouteResponseId))
+		}
+
+		if len(ids) == 0 {
+			return fmt.Errorf("API Gateway v2 WebSocket Route %s has no responses", routeKey)
+		}
+
+		*v = ids
+
+		return nil
+	}
+}
+
+func testAccCheckWebSocketRouteResponsesNotRecreated(before, after *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !reflect.DeepEqual(*before, *after) {
+			return fmt.Errorf("API Gateway v2 WebSocket Route responses were recreated: %v, now %v", *before, *after)
+		}
+
+		return nil
+	}
+}
+
+func testAccWebSocketRoutesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRouteConfig_apiWebSocket(rName),
+		`
+resource "aws_apigatewayv2_websocket_routes" "test" {
+  api_id = aws_apigatewayv2_api.test.id
+
+  route {
+    route_key                           = "$default"
+    route_response_selection_expression = "$default"
+
+    integration {
+      integration_type              = "MOCK"
+      template_selection_expression = "200"
+
+      request_templates = {
+        "200" = "{\"statusCode\": 200}"
+      }
+    }
+
+    integration_response {
+      integration_response_key = "/200/"
+    }
+
+    route_response {
+      route_response_key = "$default"
+    }
+  }
+
+  route {
+    route_key = "$connect"
+
+    integration {
+      integration_type = "MOCK"
+
+      request_templates = {
+        "$default" = "{\"statusCode\": 200}"
+      }
+    }
+  }
+}
+`)
+}
+
+func testAccWebSocketRoutesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRouteConfig_apiWebSocket(rName),
+		`
+resource "aws_apigatewayv2_websocket_routes" "test" {
+  api_id = aws_apigatewayv2_api.test.id
+
+  route {
+    route_key      = "$default"
+    operation_name = "Default"
+
+    integration {
+      integration_type              = "MOCK"
+      template_selection_expression = "201"
+
+      request_templates = {
+        "201" = "{\"statusCode\": 201}"
+      }
+    }
+
+    integration_response {
+      integration_response_key = "/201/"
+    }
+  }
+
+  route {
+    route_key = "$disconnect"
+
+    integration {
+      integration_type = "MOCK"
+
+      request_templates = {
+        "$default" = "{\"statusCode\": 200}"
+      }
+    }
+  }
+}
+`)
+}
+
+func testAccWebSocketRoutesConfig_connectOperationName(rName, operationName string) string {
+	return acctest.ConfigCompose(
+		testAccRouteConfig_apiWebSocket(rName),
+		fmt.Sprintf(`
+resource "aws_apigatewayv2_websocket_routes" "test" {
+  api_id = aws_apigatewayv2_api.test.id
+
+  route {
+    route_key                           = "$default"
+    route_response_selection_expression = "$default"
+
+    integration {
+      integration_type              = "MOCK"
+      template_selection_expression = "200"
+
+      request_templates = {
+        "200" = "{\"statusCode\": 200}"
+      }
+    }
+
+    integration_response {
+      integration_response_key = "/200/"
+    }
+
+    route_response {
+      route_response_key = "$default"
+    }
+  }
+
+  route {
+    route_key      = "$connect"
+    operation_name = %[1]q
+
+    integration {
+      integration_type = "MOCK"
+
+      request_templates = {
+        "$default" = "{\"statusCode\": 200}"
+      }
+    }
+  }
+}
+`, operationName))
+}
@@ -79,8 +79,9 @@ func ResourceScheduledAction() *schema.Resource {
 				},
 			},
 			"schedule": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validScheduleExpression,
 			},
 			// The AWS API normalizes start_time and end_time to UTC. Uses
 			// suppressEquivalentTime to allow any timezone to be used.
@@ -97,9 +98,10 @@ func ResourceScheduledAction() *schema.Resource {
 				DiffSuppressFunc: suppressEquivalentTime,
 			},
 			"timezone": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "UTC",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validTimezone,
 			},
 			"arn": {
 				Type:     schema.TypeString,
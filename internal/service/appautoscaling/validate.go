@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appautoscaling
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	_ "time/tzdata" // Timezone names are validated independently of the host's zoneinfo database.
+
+	"github.com/YakDriver/regexache"
+)
+
+var (
+	scheduleAtExpressionRegexp   = regexache.MustCompile(`^at\(([^)]+)\)$`)
+	scheduleCronExpressionRegexp = regexache.MustCompile(`^cron\((\S+ ){5}\S+\)$`)
+	scheduleRateExpressionRegexp = regexache.MustCompile(`^rate\([1-9][0-9]* (minute|minutes|hour|hours|day|days)\)$`)
+)
+
+// validScheduleExpression validates at(), cron() and rate() schedule expressions.
+// at() timestamps are interpreted in the scheduled action's timezone, so they must not carry a UTC offset.
+func validScheduleExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if m := scheduleAtExpressionRegexp.FindStringSubmatch(value); m != nil {
+		if _, err := time.Parse("2006-01-02T15:04:05", m[1]); err != nil {
+			errors = append(errors, fmt.Errorf("%q: at() expression must be in the format at(yyyy-mm-ddThh:mm:ss) without a UTC offset; use timezone to set the timezone: %s", k, value))
+		}
+
+		return
+	}
+
+	if scheduleCronExpressionRegexp.MatchString(value) || scheduleRateExpressionRegexp.MatchString(value) {
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be an at(), cron() or rate() expression, got: %s", k, value))
+
+	return
+}
+
+// validTimezone validates that the value is an IANA timezone name, e.g. America/New_York.
+func validTimezone(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// time.LoadLocation treats "Local" and "" specially.
+	if value == "" || strings.EqualFold(value, "Local") {
+		errors = append(errors, fmt.Errorf("%q must be an IANA timezone name, got: %q", k, value))
+		return
+	}
+
+	if _, err := time.LoadLocation(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an IANA timezone name, got: %s", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appautoscaling
+
+import (
+	"testing"
+)
+
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	validExpressions := []string{
+		"at(2023-06-01T12:00:00)",
+		"cron(0 18 * * ? *)",
+		"cron(15 10 ? * 6L 2022-2023)",
+		"rate(1 minute)",
+		"rate(5 minutes)",
+		"rate(12 hours)",
+	}
+	for _, v := range validExpressions {
+		_, errors := validScheduleExpression(v, "schedule")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid schedule expression: %q", v, errors)
+		}
+	}
+
+	invalidExpressions := []string{
+		"",
+		"at(2023-06-01T12:00:00Z)",
+		"at(2023-06-01T12:00:00+02:00)",
+		"at(2023-06-01 12:00:00)",
+		"cron(0 18 * * ?)",
+		"rate(0 minutes)",
+		"rate(1 week)",
+		"every day",
+	}
+	for _, v := range invalidExpressions {
+		_, errors := validScheduleExpression(v, "schedule")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid schedule expression", v)
+		}
+	}
+}
+
+func TestValidTimezone(t *testing.T) {
+	t.Parallel()
+
+	validTimezones := []string{
+		"UTC",
+		"America/New_York",
+		"Pacific/Tahiti",
+		"Etc/GMT+9",
+	}
+	for _, v := range validTimezones {
+		_, errors := validTimezone(v, "timezone")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid timezone: %q", v, errors)
+		}
+	}
+
+	invalidTimezones := []string{
+		"",
+		"Local",
+		"PST8PDT/Nowhere",
+		"Mars/Olympus_Mons",
+		"+02:00",
+	}
+	for _, v := range invalidTimezones {
+		_, errors := validTimezone(v, "timezone")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid timezone", v)
+		}
+	}
+}
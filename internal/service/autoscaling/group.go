@@ -817,6 +817,14 @@ func ResourceGroup() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							MaxItems: 1,
+							// A policy that doesn't reuse instances is equivalent to no policy.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								if old == "1" && new == "0" {
+									o, _ := d.GetChange("warm_pool.0.instance_reuse_policy.0.reuse_on_scale_in")
+									return !o.(bool)
+								}
+								return false
+							},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"reuse_on_scale_in": {
@@ -859,6 +867,7 @@ func ResourceGroup() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			customizeDiffMixedInstancesPolicyWeightedCapacity,
 		),
 	}
 }
@@ -1507,7 +1516,16 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		} else {
-			_, err := conn.PutWarmPoolWithContext(ctx, expandPutWarmPoolInput(d.Id(), w[0].(map[string]interface{})))
+			input := expandPutWarmPoolInput(d.Id(), w[0].(map[string]interface{}))
+
+			// Omitting the instance reuse policy leaves any existing policy in place.
+			if input.InstanceReusePolicy == nil {
+				input.InstanceReusePolicy = &autoscaling.InstanceReusePolicy{
+					ReuseOnScaleIn: aws.Bool(false),
+				}
+			}
+
+			_, err := conn.PutWarmPoolWithContext(ctx, input)
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Auto Scaling Warm Pool (%s): %s", d.Id(), err)
@@ -1688,6 +1706,60 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// customizeDiffMixedInstancesPolicyWeightedCapacity validates instance weights against the desired capacity type.
+// Weights are only used with the "units" capacity type, and if any override has a weight all overrides must have one.
+// The "vcpu" and "memory-mib" capacity types are only supported with attribute-based instance type selection.
+// Values that aren't known until apply (e.g. from variables of other resources' attributes) are not validated.
+func customizeDiffMixedInstancesPolicyWeightedCapacity(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	const overridesKey = "mixed_instances_policy.0.launch_template.0.override"
+
+	if !diff.NewValueKnown(overridesKey) || !diff.NewValueKnown("desired_capacity_type") {
+		return nil
+	}
+
+	overrides, ok := diff.Get(overridesKey).([]interface{})
+	if !ok || len(overrides) == 0 {
+		return nil
+	}
+
+	desiredCapacityType := diff.Get("desired_capacity_type").(string)
+	var nWeighted int
+
+	for i, tfMapRaw := range overrides {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// An unknown weight reads as empty, so whether all or none of the weights are set can't be checked.
+		if !diff.NewValueKnown(fmt.Sprintf("%s.%d.weighted_capacity", overridesKey, i)) || !diff.NewValueKnown(fmt.Sprintf("%s.%d.instance_type", overridesKey, i)) {
+			return nil
+		}
+
+		weightedCapacity := tfMap["weighted_capacity"].(string)
+		if weightedCapacity != "" {
+			nWeighted++
+		}
+
+		switch desiredCapacityType {
+		case DesiredCapacityTypeMemoryMiB, DesiredCapacityTypeVCPU:
+			if weightedCapacity != "" {
+				return fmt.Errorf("mixed_instances_policy.0.launch_template.0.override.%d.weighted_capacity: can't be set when desired_capacity_type is %q", i, desiredCapacityType)
+			}
+
+			if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+				return fmt.Errorf("mixed_instances_policy.0.launch_template.0.override.%d.instance_type: can't be set when desired_capacity_type is %q, use instance_requirements", i, desiredCapacityType)
+			}
+		}
+	}
+
+	if nWeighted > 0 && nWeighted != len(overrides) {
+		return fmt.Errorf("mixed_instances_policy.0.launch_template.0.override: weighted_capacity must be set for all overrides or for none")
+	}
+
+	return nil
+}
+
 func drainGroup(ctx context.Context, conn *autoscaling.AutoScaling, name string, instances []*autoscaling.Instance, timeout time.Duration) error {
 	input := &autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(name),
@@ -8,5 +8,6 @@ const (
 	ResNameAnomalySubscription = "Anomaly Subscription"
 	ResNameCostCategory        = "Cost Category"
 	ResNameCostAllocationTag   = "Cost Allocation Tags"
+	ResNameCostAllocationTags  = "Cost Allocation Tags (Bulk)"
 	DSNameTags                 = "Tags Data Source"
 )
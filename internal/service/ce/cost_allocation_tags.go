@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ce_cost_allocation_tags")
+func ResourceCostAllocationTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCostAllocationTagsCreate,
+		ReadWithoutTimeout:   resourceCostAllocationTagsRead,
+		UpdateWithoutTimeout: resourceCostAllocationTagsUpdate,
+		DeleteWithoutTimeout: resourceCostAllocationTagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCostAllocationTagsImport,
+		},
+
+		CustomizeDiff: customdiff.ComputedIf("unknown_tag_keys", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+			return diff.HasChanges("status", "tag_keys")
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(costexplorer.CostAllocationTagStatus_Values(), false),
+			},
+			"tag_keys": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+			"unknown_tag_keys": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCostAllocationTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn(ctx)
+
+	keys := flex.ExpandStringValueSet(d.Get("tag_keys").(*schema.Set))
+
+	if err := updateCostAllocationTagsStatus(ctx, conn, keys, d.Get("status").(string)); err != nil {
+		return create.DiagError(names.CE, create.ErrActionCreating, ResNameCostAllocationTags, "", err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return resourceCostAllocationTagsRead(ctx, d, meta)
+}
+
+func resourceCostAllocationTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn(ctx)
+
+	keys := flex.ExpandStringValueSet(d.Get("tag_keys").(*schema.Set))
+
+	costAllocTags, err := FindCostAllocationTagsByKeys(ctx, conn, keys)
+
+	if err != nil {
+		return create.DiagError(names.CE, create.ErrActionReading, ResNameCostAllocationTags, d.Id(), err)
+	}
+
+	if len(costAllocTags) == 0 && d.Get("status").(string) == "" {
+		return create.DiagError(names.CE, create.ErrActionReading, ResNameCostAllocationTags, d.Id(), fmt.Errorf("none of the tag keys %s were found", strings.Join(keys, ", ")))
+	}
+
+	status := d.Get("status").(string)
+	found := make(map[string]bool)
+
+	for _, v := range costAllocTags {
+		key := aws.StringValue(v.TagKey)
+		found[key] = true
+
+		// Report drift on any known key so that it's reconciled on the next apply.
+		if v := aws.StringValue(v.Status); v != status {
+			log.Printf("[WARN] Cost Allocation Tag (%s) status is %s", key, v)
+			status = v
+		}
+	}
+
+	var unknownKeys []string
+	for _, key := range keys {
+		if !found[key] {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+
+	d.Set("status", status)
+	d.Set("unknown_tag_keys", unknownKeys)
+
+	return nil
+}
+
+func resourceCostAllocationTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn(ctx)
+
+	o, n := d.GetChange("tag_keys")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+		if err := updateCostAllocationTagsStatus(ctx, conn, del, costexplorer.CostAllocationTagStatusInactive); err != nil {
+			return create.DiagError(names.CE, create.ErrActionUpdating, ResNameCostAllocationTags, d.Id(), err)
+		}
+	}
+
+	keys := flex.ExpandStringValueSet(ns)
+
+	// Keys that were unknown may have since appeared in billing data, so (re)apply the status to all keys.
+	if err := updateCostAllocationTagsStatus(ctx, conn, keys, d.Get("status").(string)); err != nil {
+		return create.DiagError(names.CE, create.ErrActionUpdating, ResNameCostAllocationTags, d.Id(), err)
+	}
+
+	return resourceCostAllocationTagsRead(ctx, d, meta)
+}
+
+func resourceCostAllocationTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CEConn(ctx)
+
+	keys := flex.ExpandStringValueSet(d.Get("tag_keys").(*schema.Set))
+
+	if err := updateCostAllocationTagsStatus(ctx, conn, keys, costexplorer.CostAllocationTagStatusInactive); err != nil {
+		return create.DiagError(names.CE, create.ErrActionDeleting, ResNameCostAllocationTags, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCostAllocationTagsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The import ID is a comma-separated list of tag keys, which can't themselves contain commas.
+	keys := strings.Split(d.Id(), ",")
+
+	for _, key := range keys {
+		if key == "" {
+			return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected TAG-KEY[,TAG-KEY]...", d.Id())
+		}
+	}
+
+	d.Set("tag_keys", keys)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateCostAllocationTagsStatus sets the status of the specified tag keys in batches.
+// Tag keys that haven't yet appeared in billing data are logged and otherwise ignored.
+// Failures for any other tag key are returned.
+func updateCostAllocationTagsStatus(ctx context.Context, conn *costexplorer.CostExplorer, keys []string, status string) error {
+	const (
+		maxCostAllocationTagsStatus = 20
+	)
+
+	for _, chunk := range slices.Chunks(keys, maxCostAllocationTagsStatus) {
+		input := &costexplorer.UpdateCostAllocationTagsStatusInput{
+			CostAllocationTagsStatus: slices.ApplyToAll(chunk, func(key string) *costexplorer.CostAllocationTagStatusEntry {
+				return &costexplorer.CostAllocationTagStatusEntry{
+					Status: aws.String(status),
+					TagKey: aws.String(key),
+				}
+			}),
+		}
+
+		output, err := conn.UpdateCostAllocationTagsStatusWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Errors) == 0 {
+			continue
+		}
+
+		// Failure codes aren't documented, so unknown tag keys are identified by looking them up.
+		var failedKeys []string
+		for _, v := range output.Errors {
+			if v != nil {
+				failedKeys = append(failedKeys, aws.StringValue(v.TagKey))
+			}
+		}
+
+		found, err := FindCostAllocationTagsByKeys(ctx, conn, failedKeys)
+
+		if err != nil {
+			return err
+		}
+
+		knownKeys := make(map[string]bool)
+		for _, v := range found {
+			knownKeys[aws.StringValue(v.TagKey)] = true
+		}
+
+		var errs []error
+		for _, v := range output.Errors {
+			if v == nil {
+				continue
+			}
+
+			key := aws.StringValue(v.TagKey)
+
+			if !knownKeys[key] {
+				log.Printf("[WARN] updating Cost Allocation Tag (%s) status: %s: %s", key, aws.StringValue(v.Code), aws.StringValue(v.Message))
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("updating Cost Allocation Tag (%s) status: %w", key, awserr.New(aws.StringValue(v.Code), aws.StringValue(v.Message), nil)))
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
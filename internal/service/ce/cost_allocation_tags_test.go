@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ce_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+)
+
+func TestAccCECostAllocationTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ce_cost_allocation_tags.test"
+	unknownKey := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCostAllocationTagsDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCostAllocationTagsConfig_basic("Tag01", unknownKey, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+					resource.TestCheckResourceAttr(resourceName, "tag_keys.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "unknown_tag_keys.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "unknown_tag_keys.*", unknownKey),
+				),
+			},
+			{
+				// Imported resources get the tag keys as their ID.
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("Tag01,%s", unknownKey),
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					if got, want := s[0].Attributes["tag_keys.#"], "2"; got != want {
+						return fmt.Errorf("tag_keys.# = %s, want %s", got, want)
+					}
+
+					if got, want := s[0].Attributes["status"], "Active"; got != want {
+						return fmt.Errorf("status = %s, want %s", got, want)
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: testAccCostAllocationTagsConfig_basic("Tag01", unknownKey, "Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "Inactive"),
+					resource.TestCheckResourceAttr(resourceName, "unknown_tag_keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCostAllocationTagsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CEConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ce_cost_allocation_tags" {
+				continue
+			}
+
+			var keys []string
+			for k, v := range rs.Primary.Attributes {
+				if strings.HasPrefix(k, "tag_keys.") && k != "tag_keys.#" {
+					keys = append(keys, v)
+				}
+			}
+
+			output, err := tfce.FindCostAllocationTagsByKeys(ctx, conn, keys)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range output {
+				if status := aws.StringValue(v.Status); status != costexplorer.CostAllocationTagStatusInactive {
+					return fmt.Errorf("Cost Allocation Tag %s is still %s", aws.StringValue(v.TagKey), status)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCostAllocationTagsConfig_basic(key1, key2, status string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_cost_allocation_tags" "test" {
+  tag_keys = [%[1]q, %[2]q]
+  status   = %[3]q
+}
+`, key1, key2, status)
+}
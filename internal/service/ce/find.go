@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/costexplorer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -91,6 +92,45 @@ func FindCostAllocationTagByKey(ctx context.Context, conn *costexplorer.CostExpl
 	return out.CostAllocationTags[0], nil
 }
 
+func FindCostAllocationTagsByKeys(ctx context.Context, conn *costexplorer.CostExplorer, keys []string) ([]*costexplorer.CostAllocationTag, error) {
+	const (
+		maxTagKeys = 100
+	)
+	var output []*costexplorer.CostAllocationTag
+
+	for _, chunk := range slices.Chunks(keys, maxTagKeys) {
+		in := &costexplorer.ListCostAllocationTagsInput{
+			TagKeys: aws.StringSlice(chunk),
+		}
+
+		for {
+			out, err := conn.ListCostAllocationTagsWithContext(ctx, in)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if out == nil {
+				break
+			}
+
+			for _, v := range out.CostAllocationTags {
+				if v != nil {
+					output = append(output, v)
+				}
+			}
+
+			if aws.StringValue(out.NextToken) == "" {
+				break
+			}
+
+			in.NextToken = out.NextToken
+		}
+	}
+
+	return output, nil
+}
+
 func FindCostCategoryByARN(ctx context.Context, conn *costexplorer.CostExplorer, arn string) (*costexplorer.CostCategory, error) {
 	in := &costexplorer.DescribeCostCategoryDefinitionInput{
 		CostCategoryArn: aws.String(arn),
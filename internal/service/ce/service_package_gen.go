@@ -58,6 +58,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceCostAllocationTag,
 			TypeName: "aws_ce_cost_allocation_tag",
 		},
+		{
+			Factory:  ResourceCostAllocationTags,
+			TypeName: "aws_ce_cost_allocation_tags",
+		},
 		{
 			Factory:  ResourceCostCategory,
 			TypeName: "aws_ce_cost_category",
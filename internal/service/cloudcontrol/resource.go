@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -20,12 +22,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/mattbaird/jsonpatch"
 )
 
@@ -45,8 +49,10 @@ func ResourceResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 			},
 			"properties": {
 				Type:     schema.TypeString,
@@ -77,9 +83,6 @@ func ResourceResource() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			resourceResourceCustomizeDiffGetSchema,
 			resourceResourceCustomizeDiffSchemaDiff,
-			customdiff.ComputedIf("properties", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange("desired_state")
-			}),
 		),
 	}
 }
@@ -146,7 +149,14 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("reading Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
 	}
 
-	d.Set("properties", resourceDescription.Properties)
+	// Normalize properties the same way as the planned value in CustomizeDiff, so that the applied value matches the plan.
+	properties, err := structure.NormalizeJsonString(aws.ToString(resourceDescription.Properties))
+
+	if err != nil {
+		return diag.Errorf("normalizing Cloud Control API (%s) Resource (%s) properties: %s", typeName, d.Id(), err)
+	}
+
+	d.Set("properties", properties)
 
 	return nil
 }
@@ -267,6 +277,10 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 
 	// desired_state can be empty if unknown
 	if newDesiredState == "" {
+		if diff.HasChange("desired_state") {
+			return diff.SetNewComputed("properties")
+		}
+
 		return nil
 	}
 
@@ -303,17 +317,84 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return fmt.Errorf("creating desired_state JSON Patch: %w", err)
 	}
 
+	var createOnlyPaths []string
 	for _, patch := range patches {
 		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
-			if err := diff.ForceNew("desired_state"); err != nil {
-				return fmt.Errorf("setting desired_state ForceNew: %w", err)
-			}
+			createOnlyPaths = append(createOnlyPaths, patch.Path)
+		}
+	}
+
+	if len(createOnlyPaths) > 0 {
+		log.Printf("[DEBUG] Cloud Control API (%s) Resource (%s) create-only properties changed: %s", diff.Get("type_name").(string), diff.Id(), strings.Join(createOnlyPaths, ", "))
 
-			break
+		if err := diff.ForceNew("desired_state"); err != nil {
+			return fmt.Errorf("setting desired_state ForceNew: %w", err)
 		}
+
+		return diff.SetNewComputed("properties")
 	}
 
-	return nil
+	// Show the change at the property level rather than marking all properties unknown.
+	if v, ok := plannedProperties(diff.Get("properties").(string), oldDesiredStateRaw.(string), newDesiredState, patches); ok {
+		return diff.SetNew("properties", v)
+	}
+
+	return diff.SetNewComputed("properties")
+}
+
+// plannedProperties returns the expected resource properties after applying the desired_state patches to the current properties.
+// Planning is only possible when every patch replaces part of a top-level property whose current value is exactly the previously
+// desired value. Other changes may be affected by write-only properties or service-side defaults, so the prediction could be incomplete.
+func plannedProperties(properties, oldDesiredState, desiredState string, patches []jsonpatch.JsonPatchOperation) (string, bool) {
+	if properties == "" || len(patches) == 0 {
+		return "", false
+	}
+
+	var current, oldDesired, desired map[string]interface{}
+
+	if err := json.Unmarshal([]byte(properties), &current); err != nil {
+		return "", false
+	}
+
+	if err := json.Unmarshal([]byte(oldDesiredState), &oldDesired); err != nil {
+		return "", false
+	}
+
+	if err := json.Unmarshal([]byte(desiredState), &desired); err != nil {
+		return "", false
+	}
+
+	for _, patch := range patches {
+		if patch.Operation != "replace" {
+			return "", false
+		}
+
+		name, _, _ := strings.Cut(strings.TrimPrefix(patch.Path, "/"), "/")
+		name = strings.NewReplacer("~1", "/", "~0", "~").Replace(name)
+
+		// A current value that differs from what was desired includes service-side defaults or normalization,
+		// which the desired value can't predict.
+		if v, ok := current[name]; !ok || !reflect.DeepEqual(v, oldDesired[name]) {
+			return "", false
+		}
+
+		v, ok := desired[name]
+
+		if !ok {
+			return "", false
+		}
+
+		current[name] = v
+	}
+
+	// The result is encoded like structure.NormalizeJsonString, which Read uses.
+	b, err := json.Marshal(current)
+
+	if err != nil {
+		return "", false
+	}
+
+	return string(b), true
 }
 
 func FindResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string) (*types.ResourceDescription, error) {
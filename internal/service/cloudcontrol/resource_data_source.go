@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
@@ -63,7 +64,13 @@ func dataSourceResourceRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.ToString(resourceDescription.Identifier))
 
-	d.Set("properties", resourceDescription.Properties)
+	properties, err := structure.NormalizeJsonString(aws.ToString(resourceDescription.Properties))
+
+	if err != nil {
+		return diag.Errorf("normalizing Cloud Control API (%s) Resource (%s) properties: %s", typeName, identifier, err)
+	}
+
+	d.Set("properties", properties)
 
 	return nil
 }
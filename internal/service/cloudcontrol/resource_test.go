@@ -12,6 +12,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/mattbaird/jsonpatch"
 )
 
 func init() {
@@ -31,6 +33,106 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+func TestPlannedProperties(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		properties   string
+		oldDesired   string
+		newDesired   string
+		expected     string
+		expectedOkay bool
+	}{
+		"replace top-level value": {
+			properties:   `{"Arn":"arn","LogGroupName":"test","RetentionInDays":7}`,
+			oldDesired:   `{"LogGroupName":"test","RetentionInDays":7}`,
+			newDesired:   `{"LogGroupName":"test","RetentionInDays":14}`,
+			expected:     `{"Arn":"arn","LogGroupName":"test","RetentionInDays":14}`,
+			expectedOkay: true,
+		},
+		"unsorted and escaped properties": {
+			properties:   `{"RetentionInDays":7,"LogGroupName":"a<b"}`,
+			oldDesired:   `{"LogGroupName":"a<b","RetentionInDays":7}`,
+			newDesired:   `{"LogGroupName":"a<b","RetentionInDays":14}`,
+			expected:     `{"LogGroupName":"a\u003cb","RetentionInDays":14}`,
+			expectedOkay: true,
+		},
+		"replace nested value": {
+			properties:   `{"Name":"test","Tags":{"key1":"value1"}}`,
+			oldDesired:   `{"Name":"test","Tags":{"key1":"value1"}}`,
+			newDesired:   `{"Name":"test","Tags":{"key1":"value2"}}`,
+			expected:     `{"Name":"test","Tags":{"key1":"value2"}}`,
+			expectedOkay: true,
+		},
+		"add value": {
+			properties: `{"LogGroupName":"test"}`,
+			oldDesired: `{"LogGroupName":"test"}`,
+			newDesired: `{"LogGroupName":"test","RetentionInDays":14}`,
+		},
+		"remove value": {
+			properties: `{"LogGroupName":"test","RetentionInDays":7}`,
+			oldDesired: `{"LogGroupName":"test","RetentionInDays":7}`,
+			newDesired: `{"LogGroupName":"test"}`,
+		},
+		"replace write-only value": {
+			properties: `{"LogGroupName":"test"}`,
+			oldDesired: `{"LogGroupName":"test","Secret":"a"}`,
+			newDesired: `{"LogGroupName":"test","Secret":"b"}`,
+		},
+		"replace value with service-side defaults": {
+			properties: `{"Name":"test","Config":{"Enabled":true,"Mode":"default"}}`,
+			oldDesired: `{"Name":"test","Config":{"Enabled":true}}`,
+			newDesired: `{"Name":"test","Config":{"Enabled":false}}`,
+		},
+		"replace normalized value": {
+			properties: `{"Name":"test","Policy":{"Version":"2012-10-17","Statement":[]}}`,
+			oldDesired: `{"Name":"test","Policy":"{\"Version\":\"2012-10-17\",\"Statement\":[]}"}`,
+			newDesired: `{"Name":"test","Policy":"{\"Version\":\"2012-10-17\",\"Statement\":[{}]}"}`,
+		},
+		"no properties": {
+			oldDesired: `{"LogGroupName":"test","RetentionInDays":7}`,
+			newDesired: `{"LogGroupName":"test","RetentionInDays":14}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			patches, err := jsonpatch.CreatePatch([]byte(testCase.oldDesired), []byte(testCase.newDesired))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, ok := tfcloudcontrol.PlannedProperties(testCase.properties, testCase.oldDesired, testCase.newDesired, patches)
+
+			if ok != testCase.expectedOkay {
+				t.Fatalf("got ok %t, expected %t", ok, testCase.expectedOkay)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+
+			// Read normalizes the properties, so the planned value must already be normalized.
+			if ok {
+				normalized, err := structure.NormalizeJsonString(got)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if got != normalized {
+					t.Errorf("got %s, which normalizes to %s", got, normalized)
+				}
+			}
+		})
+	}
+}
+
 func TestAccCloudControlResource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -5,6 +5,7 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -69,6 +71,17 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"test_events": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 40960),
+						validation.StringIsJSON,
+					),
+				},
+			},
 		},
 	}
 }
@@ -96,6 +109,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.StringValue(output.FunctionSummary.Name))
 
+	if err := testFunction(ctx, conn, d.Id(), aws.StringValue(output.ETag), flex.ExpandStringValueList(d.Get("test_events").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "testing CloudFront Function (%s): %s", d.Id(), err)
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			Name:    aws.String(d.Id()),
@@ -186,6 +203,16 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		etag = aws.StringValue(output.ETag)
 	}
 
+	if err := testFunction(ctx, conn, d.Id(), etag, flex.ExpandStringValueList(d.Get("test_events").([]interface{}))); err != nil {
+		// The untested code is now in the DEVELOPMENT stage. Keep the previous code in state
+		// so that the next plan shows the change again, along with the new ETag.
+		o, _ := d.GetChange("code")
+		d.Set("code", o)
+		d.Set("etag", etag)
+
+		return sdkdiag.AppendErrorf(diags, "testing CloudFront Function (%s): %s", d.Id(), err)
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			Name:    aws.String(d.Id()),
@@ -223,3 +250,30 @@ func resourceFunctionDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 	return diags
 }
+
+// testFunction runs the DEVELOPMENT stage of the function against each event object, returning an error if any execution fails.
+func testFunction(ctx context.Context, conn *cloudfront.CloudFront, name, etag string, events []string) error {
+	for i, event := range events {
+		input := &cloudfront.TestFunctionInput{
+			EventObject: []byte(event),
+			IfMatch:     aws.String(etag),
+			Name:        aws.String(name),
+			Stage:       aws.String(cloudfront.FunctionStageDevelopment),
+		}
+
+		log.Printf("[DEBUG] Testing CloudFront Function: %s", name)
+		output, err := conn.TestFunctionWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		if output.TestResult != nil {
+			if v := aws.StringValue(output.TestResult.FunctionErrorMessage); v != "" {
+				return fmt.Errorf("test event %d: %s", i, v)
+			}
+		}
+	}
+
+	return nil
+}
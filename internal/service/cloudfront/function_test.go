@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -289,6 +290,34 @@ func TestAccCloudFrontFunction_Update_comment(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontFunction_testEvents(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf cloudfront.DescribeFunctionOutput
+	resourceName := "aws_cloudfront_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudfront.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionConfig_testEvents(rName, "event.request.headers.host.value"),
+				ExpectError: regexache.MustCompile(`test event 0`),
+			},
+			{
+				Config: testAccFunctionConfig_testEvents(rName, "event.request.uri"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "test_events.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "live_stage_etag"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFunctionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn(ctx)
@@ -576,3 +605,41 @@ EOT
 }
 `, rName, comment)
 }
+
+func testAccFunctionConfig_testEvents(rName, location string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-1.0"
+  code    = <<-EOT
+function handler(event) {
+	var response = {
+		statusCode: 302,
+		statusDescription: 'Found',
+		headers: {
+			'location': { value: %[2]s.toLowerCase() }
+		}
+	};
+	return response;
+}
+EOT
+
+  test_events = [jsonencode({
+    version = "1.0"
+    context = {
+      eventType = "viewer-request"
+    }
+    viewer = {
+      ip = "198.51.100.1"
+    }
+    request = {
+      method      = "GET"
+      uri         = "/index.html"
+      headers     = {}
+      cookies     = {}
+      querystring = {}
+    }
+  })]
+}
+`, rName, location)
+}
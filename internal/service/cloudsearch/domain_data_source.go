@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_cloudsearch_domain")
+func DataSourceDomain() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDomainRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforce_https": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tls_security_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"index_field": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analysis_scheme": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"facet": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"highlight": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"return": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"search": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"sort": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"source_fields": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scaling_parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"desired_partition_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"desired_replication_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"search_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudSearchConn(ctx)
+
+	name := d.Get("name").(string)
+	domainStatus, err := FindDomainStatusByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(domainStatus.DomainName))
+	d.Set("arn", domainStatus.ARN)
+	d.Set("domain_id", domainStatus.DomainId)
+	d.Set("name", domainStatus.DomainName)
+
+	if domainStatus.DocService != nil {
+		d.Set("document_service_endpoint", domainStatus.DocService.Endpoint)
+	} else {
+		d.Set("document_service_endpoint", nil)
+	}
+	if domainStatus.SearchService != nil {
+		d.Set("search_service_endpoint", domainStatus.SearchService.Endpoint)
+	} else {
+		d.Set("search_service_endpoint", nil)
+	}
+
+	accessPolicy, err := FindAccessPolicyByName(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("access_policy", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) access policy: %s", d.Id(), err)
+	default:
+		d.Set("access_policy", accessPolicy)
+	}
+
+	availabilityOptionStatus, err := findAvailabilityOptionsStatusByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) availability options: %s", d.Id(), err)
+	}
+
+	d.Set("multi_az", availabilityOptionStatus.Options)
+
+	endpointOptions, err := findDomainEndpointOptionsByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) endpoint options: %s", d.Id(), err)
+	}
+
+	if err := d.Set("endpoint_options", []interface{}{flattenDomainEndpointOptions(endpointOptions)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint_options: %s", err)
+	}
+
+	scalingParameters, err := findScalingParametersByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) scaling parameters: %s", d.Id(), err)
+	}
+
+	if err := d.Set("scaling_parameters", []interface{}{flattenScalingParameters(scalingParameters)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scaling_parameters: %s", err)
+	}
+
+	indexResults, err := conn.DescribeIndexFieldsWithContext(ctx, &cloudsearch.DescribeIndexFieldsInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) index fields: %s", d.Id(), err)
+	}
+
+	if tfList, err := flattenIndexFieldStatuses(indexResults.IndexFields); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s): %s", d.Id(), err)
+	} else if err := d.Set("index_field", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting index_field: %s", err)
+	}
+
+	return diags
+}
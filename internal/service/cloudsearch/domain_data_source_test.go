@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudSearchDomainDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudsearch_domain.test"
+	resourceName := "aws_cloudsearch_domain.test"
+	rName := testAccDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, cloudsearch.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudsearch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "document_service_endpoint", resourceName, "document_service_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_id", resourceName, "domain_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_options.#", resourceName, "endpoint_options.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_options.0.enforce_https", resourceName, "endpoint_options.0.enforce_https"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_options.0.tls_security_policy", resourceName, "endpoint_options.0.tls_security_policy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_field.#", resourceName, "index_field.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "index_field.*", map[string]string{
+						"name": "latlon_test",
+						"type": "latlon",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "multi_az", resourceName, "multi_az"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scaling_parameters.#", resourceName, "scaling_parameters.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scaling_parameters.0.desired_instance_type", resourceName, "scaling_parameters.0.desired_instance_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "search_service_endpoint", resourceName, "search_service_endpoint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_allOptions(rName), `
+data "aws_cloudsearch_domain" "test" {
+  name = aws_cloudsearch_domain.test.name
+}
+`)
+}
@@ -5,12 +5,17 @@ package cloudwatch
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -118,7 +123,10 @@ func ResourceCompositeAlarm() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffCompositeAlarmRule,
+		),
 	}
 }
 
@@ -261,6 +269,103 @@ func FindCompositeAlarmByName(ctx context.Context, conn *cloudwatch.CloudWatch,
 	return tfresource.AssertSinglePtrResult(output.CompositeAlarms)
 }
 
+// customizeDiffCompositeAlarmRule detects alarm rules that reference the alarm itself, either directly or via other composite alarms.
+// PutCompositeAlarm otherwise rejects such rules only at apply time.
+func customizeDiffCompositeAlarmRule(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("alarm_name") || !diff.NewValueKnown("alarm_rule") {
+		return nil
+	}
+
+	name := diff.Get("alarm_name").(string)
+
+	if v, ok := diff.GetOk("actions_suppressor"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v, ok := v.([]interface{})[0].(map[string]interface{})["alarm"].(string); ok && compositeAlarmRuleAlarmName(v) == name {
+			return fmt.Errorf("actions_suppressor.0.alarm cannot be the composite alarm itself (%s)", name)
+		}
+	}
+
+	if diff.Id() != "" && !diff.HasChange("alarm_rule") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).CloudWatchConn(ctx)
+
+	// Breadth-first search of the composite alarms referenced by the rule, recording how each was reached.
+	parents := map[string]string{}
+	queue := []string{}
+
+	for _, v := range compositeAlarmRuleAlarmNames(diff.Get("alarm_rule").(string)) {
+		if _, ok := parents[v]; !ok {
+			parents[v] = name
+			queue = append(queue, v)
+		}
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == name {
+			path := []string{name}
+			for v := parents[current]; v != name; v = parents[v] {
+				path = append([]string{v}, path...)
+			}
+			path = append([]string{name}, path...)
+
+			return fmt.Errorf("alarm_rule creates a cycle between composite alarms: %s", strings.Join(path, " -> "))
+		}
+
+		alarm, err := FindCompositeAlarmByName(ctx, conn, current)
+
+		// Metric alarms and alarms that don't exist yet can't complete a cycle.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading CloudWatch Composite Alarm (%s): %w", current, err)
+		}
+
+		for _, v := range compositeAlarmRuleAlarmNames(aws.StringValue(alarm.AlarmRule)) {
+			if _, ok := parents[v]; !ok {
+				parents[v] = current
+				queue = append(queue, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+var compositeAlarmRuleAlarmStateFunctionRegexp = regexache.MustCompile(`\b(?:ALARM|OK|INSUFFICIENT_DATA)\s*\(\s*(?:"([^"]*)"|'([^']*)'|([^\s()"']+))\s*\)`)
+
+// compositeAlarmRuleAlarmNames returns the names of the alarms referenced by a composite alarm rule's state functions.
+func compositeAlarmRuleAlarmNames(rule string) []string {
+	var names []string
+
+	for _, m := range compositeAlarmRuleAlarmStateFunctionRegexp.FindAllStringSubmatch(rule, -1) {
+		for _, v := range m[1:] {
+			if v != "" {
+				names = append(names, compositeAlarmRuleAlarmName(v))
+				break
+			}
+		}
+	}
+
+	return names
+}
+
+// compositeAlarmRuleAlarmName returns the alarm name for an alarm name or ARN.
+func compositeAlarmRuleAlarmName(v string) string {
+	if arn.IsARN(v) {
+		if v, err := arn.Parse(v); err == nil {
+			return strings.TrimPrefix(v.Resource, "alarm:")
+		}
+	}
+
+	return v
+}
+
 func expandPutCompositeAlarmInput(ctx context.Context, d *schema.ResourceData) *cloudwatch.PutCompositeAlarmInput {
 	apiObject := &cloudwatch.PutCompositeAlarmInput{
 		ActionsEnabled: aws.Bool(d.Get("actions_enabled").(bool)),
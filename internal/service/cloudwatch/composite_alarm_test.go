@@ -420,6 +420,92 @@ func TestAccCloudWatchCompositeAlarm_actionsSuppressor(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchCompositeAlarm_alarmRuleCycle(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositeAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCompositeAlarmConfig_selfReference(rName),
+				ExpectError: regexache.MustCompile(`alarm_rule creates a cycle between composite alarms`),
+			},
+			{
+				Config: testAccCompositeAlarmConfig_chain(rName, "ALARM(${aws_cloudwatch_metric_alarm.test[0].alarm_name})"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCompositeAlarmExists(ctx, "aws_cloudwatch_composite_alarm.test"),
+					testAccCheckCompositeAlarmExists(ctx, "aws_cloudwatch_composite_alarm.parent"),
+				),
+			},
+			{
+				Config:      testAccCompositeAlarmConfig_chain(rName, fmt.Sprintf("ALARM(%s-parent)", rName)),
+				ExpectError: regexache.MustCompile(fmt.Sprintf(`alarm_rule creates a cycle between composite alarms: %[1]s -> %[1]s-parent -> %[1]s`, rName)),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchCompositeAlarm_actionsSuppressorSelf(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCompositeAlarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCompositeAlarmConfig_actionSuppressorSelf(rName),
+				ExpectError: regexache.MustCompile(`actions_suppressor.0.alarm cannot be the composite alarm itself`),
+			},
+		},
+	})
+}
+
+func TestCompositeAlarmRuleAlarmNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		rule     string
+		expected []string
+	}{
+		{
+			rule: "TRUE",
+		},
+		{
+			rule:     "ALARM(CPUUtilizationTooHigh) OR ALARM(DiskReadOpsTooHigh)",
+			expected: []string{"CPUUtilizationTooHigh", "DiskReadOpsTooHigh"},
+		},
+		{
+			rule:     `ALARM("my alarm") AND NOT (OK('other alarm') OR INSUFFICIENT_DATA( third ))`,
+			expected: []string{"my alarm", "other alarm", "third"},
+		},
+		{
+			rule:     "ALARM(arn:aws:cloudwatch:us-west-2:123456789012:alarm:Example)", //lintignore:AWSAT003,AWSAT005
+			expected: []string{"Example"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		got := tfcloudwatch.CompositeAlarmRuleAlarmNames(testCase.rule)
+
+		if len(got) != len(testCase.expected) {
+			t.Fatalf("%q: expected %v, got %v", testCase.rule, testCase.expected, got)
+		}
+
+		for i := range got {
+			if got[i] != testCase.expected[i] {
+				t.Fatalf("%q: expected %v, got %v", testCase.rule, testCase.expected, got)
+			}
+		}
+	}
+}
+
 func testAccCheckCompositeAlarmDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn(ctx)
@@ -680,3 +766,41 @@ resource "aws_cloudwatch_composite_alarm" "test" {
 }
 `, rName))
 }
+
+func testAccCompositeAlarmConfig_selfReference(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = "ALARM(%[1]s)"
+}
+`, rName)
+}
+
+func testAccCompositeAlarmConfig_chain(rName, alarmRule string) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = %[2]q
+}
+
+resource "aws_cloudwatch_composite_alarm" "parent" {
+  alarm_name = "%[1]s-parent"
+  alarm_rule = "ALARM(${aws_cloudwatch_composite_alarm.test.alarm_name})"
+}
+`, rName, alarmRule))
+}
+
+func testAccCompositeAlarmConfig_actionSuppressorSelf(rName string) string {
+	return acctest.ConfigCompose(testAccCompositeAlarmConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = "ALARM(${aws_cloudwatch_metric_alarm.test[0].alarm_name})"
+
+  actions_suppressor {
+    alarm            = %[1]q
+    extension_period = 10
+    wait_period      = 20
+  }
+}
+`, rName))
+}
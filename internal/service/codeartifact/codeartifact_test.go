@@ -39,6 +39,7 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"owner":              testAccRepository_owner,
 			"tags":               testAccRepository_tags,
 			"upstreams":          testAccRepository_upstreams,
+			"upstreamsDuplicate": testAccRepository_upstreamsDuplicate,
 		},
 		"RepositoryEndpointDataSource": {
 			"basic": testAccRepositoryEndpointDataSource_basic,
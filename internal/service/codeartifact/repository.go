@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/codeartifact"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
@@ -64,6 +65,7 @@ func ResourceRepository() *schema.Resource {
 			"upstream": {
 				Type:     schema.TypeList,
 				MinItems: 1,
+				MaxItems: 10,
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -103,7 +105,10 @@ func ResourceRepository() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffRepositoryUpstreams,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -221,10 +226,9 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if d.HasChange("upstream") {
-		if v, ok := d.GetOk("upstream"); ok {
-			params.Upstreams = expandUpstreams(v.([]interface{}))
-			needsUpdate = true
-		}
+		// An empty list removes all upstream repositories.
+		params.Upstreams = expandUpstreams(d.Get("upstream").([]interface{}))
+		needsUpdate = true
 	}
 
 	if needsUpdate {
@@ -296,6 +300,32 @@ func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// customizeDiffRepositoryUpstreams rejects an upstream repository listed more than once,
+// as the list order is the priority order used when resolving package versions.
+func customizeDiffRepositoryUpstreams(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	seen := make(map[string]int)
+
+	for i, tfMapRaw := range d.Get("upstream").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, ok := tfMap["repository_name"].(string)
+		if !ok || name == "" {
+			continue
+		}
+
+		if j, ok := seen[name]; ok {
+			return fmt.Errorf("upstream.%d.repository_name: %q duplicates upstream.%d", i, name, j)
+		}
+
+		seen[name] = i
+	}
+
+	return nil
+}
+
 func expandUpstreams(l []interface{}) []*codeartifact.UpstreamRepository {
 	upstreams := []*codeartifact.UpstreamRepository{}
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codeartifact"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -200,6 +201,15 @@ func testAccRepository_upstreams(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "upstream.1.repository_name", fmt.Sprintf("%s-upstream2", rName)),
 				),
 			},
+			{
+				Config: testAccRepositoryConfig_upstreams2Reordered(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upstream.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upstream.0.repository_name", fmt.Sprintf("%s-upstream2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "upstream.1.repository_name", fmt.Sprintf("%s-upstream1", rName)),
+				),
+			},
 			{
 				Config: testAccRepositoryConfig_upstreams1(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -208,6 +218,31 @@ func testAccRepository_upstreams(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "upstream.0.repository_name", fmt.Sprintf("%s-upstream1", rName)),
 				),
 			},
+			{
+				Config: testAccRepositoryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRepositoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upstream.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepository_upstreamsDuplicate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, codeartifact.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, codeartifact.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRepositoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRepositoryConfig_upstreamsDuplicate(rName),
+				ExpectError: regexache.MustCompile(`upstream.1.repository_name: .* duplicates upstream.0`),
+			},
 		},
 	})
 }
@@ -433,6 +468,50 @@ resource "aws_codeartifact_repository" "test" {
 `, rName)
 }
 
+func testAccRepositoryConfig_upstreams2Reordered(rName string) string {
+	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_codeartifact_repository" "upstream1" {
+  repository = "%[1]s-upstream1"
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_repository" "upstream2" {
+  repository = "%[1]s-upstream2"
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+
+  upstream {
+    repository_name = aws_codeartifact_repository.upstream2.repository
+  }
+
+  upstream {
+    repository_name = aws_codeartifact_repository.upstream1.repository
+  }
+}
+`, rName)
+}
+
+func testAccRepositoryConfig_upstreamsDuplicate(rName string) string {
+	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+
+  upstream {
+    repository_name = "%[1]s-upstream1"
+  }
+
+  upstream {
+    repository_name = "%[1]s-upstream1"
+  }
+}
+`, rName)
+}
+
 func testAccRepositoryConfig_externalConnection(rName string) string {
 	return testAccRepositoryBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_codeartifact_repository" "test" {
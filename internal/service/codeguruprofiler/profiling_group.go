@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
+)
+
+// @SDKResource("aws_codeguruprofiler_profiling_group", name="Profiling Group")
+// @Tags(identifierAttribute="arn")
+func ResourceProfilingGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProfilingGroupCreate,
+		ReadWithoutTimeout:   resourceProfilingGroupRead,
+		UpdateWithoutTimeout: resourceProfilingGroupUpdate,
+		DeleteWithoutTimeout: resourceProfilingGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"agent_orchestration_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"profiling_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"agent_permissions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principals": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_platform": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      codeguruprofiler.ComputePlatformDefault,
+				ValidateFunc: validation.StringInSlice(codeguruprofiler.ComputePlatform_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexache.MustCompile(`^[\w-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceProfilingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeGuruProfilerConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &codeguruprofiler.CreateProfilingGroupInput{
+		ClientToken:        aws.String(id.UniqueId()),
+		ComputePlatform:    aws.String(d.Get("compute_platform").(string)),
+		ProfilingGroupName: aws.String(name),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("agent_orchestration_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AgentOrchestrationConfig = expandAgentOrchestrationConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateProfilingGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeGuru Profiler Profiling Group (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if principals := expandAgentPermissionsPrincipals(d.Get("agent_permissions").([]interface{})); len(principals) > 0 {
+		if err := putAgentPermissions(ctx, conn, d.Id(), principals); err != nil {
+			return sdkdiag.AppendErrorf(diags, "putting CodeGuru Profiler Profiling Group (%s) agent permissions: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProfilingGroupRead(ctx, d, meta)...)
+}
+
+func resourceProfilingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeGuruProfilerConn(ctx)
+
+	profilingGroup, err := FindProfilingGroupByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeGuru Profiler Profiling Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeGuru Profiler Profiling Group (%s): %s", d.Id(), err)
+	}
+
+	if profilingGroup.AgentOrchestrationConfig != nil {
+		if err := d.Set("agent_orchestration_config", []interface{}{flattenAgentOrchestrationConfig(profilingGroup.AgentOrchestrationConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting agent_orchestration_config: %s", err)
+		}
+	} else {
+		d.Set("agent_orchestration_config", nil)
+	}
+	d.Set("arn", profilingGroup.Arn)
+	d.Set("compute_platform", profilingGroup.ComputePlatform)
+	d.Set("name", profilingGroup.Name)
+
+	policy, err := FindPolicyByProfilingGroupName(ctx, conn, d.Id())
+
+	if err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "reading CodeGuru Profiler Profiling Group (%s) policy: %s", d.Id(), err)
+	}
+
+	var principals []string
+	if policy != nil {
+		principals, err = agentPermissionsPrincipals(aws.StringValue(policy.Policy))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CodeGuru Profiler Profiling Group (%s) policy: %s", d.Id(), err)
+		}
+	}
+
+	if len(principals) > 0 {
+		if err := d.Set("agent_permissions", []interface{}{map[string]interface{}{"principals": principals}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting agent_permissions: %s", err)
+		}
+	} else {
+		d.Set("agent_permissions", nil)
+	}
+
+	setTagsOut(ctx, profilingGroup.Tags)
+
+	return diags
+}
+
+func resourceProfilingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeGuruProfilerConn(ctx)
+
+	if d.HasChange("agent_orchestration_config") {
+		if v, ok := d.GetOk("agent_orchestration_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := &codeguruprofiler.UpdateProfilingGroupInput{
+				AgentOrchestrationConfig: expandAgentOrchestrationConfig(v.([]interface{})[0].(map[string]interface{})),
+				ProfilingGroupName:       aws.String(d.Id()),
+			}
+
+			_, err := conn.UpdateProfilingGroupWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating CodeGuru Profiler Profiling Group (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("agent_permissions") {
+		if principals := expandAgentPermissionsPrincipals(d.Get("agent_permissions").([]interface{})); len(principals) > 0 {
+			if err := putAgentPermissions(ctx, conn, d.Id(), principals); err != nil {
+				return sdkdiag.AppendErrorf(diags, "putting CodeGuru Profiler Profiling Group (%s) agent permissions: %s", d.Id(), err)
+			}
+		} else {
+			if err := removeAgentPermissions(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing CodeGuru Profiler Profiling Group (%s) agent permissions: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceProfilingGroupRead(ctx, d, meta)...)
+}
+
+func resourceProfilingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeGuruProfilerConn(ctx)
+
+	log.Printf("[INFO] Deleting CodeGuru Profiler Profiling Group: %s", d.Id())
+	_, err := conn.DeleteProfilingGroupWithContext(ctx, &codeguruprofiler.DeleteProfilingGroupInput{
+		ProfilingGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeGuru Profiler Profiling Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindProfilingGroupByName(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string) (*codeguruprofiler.ProfilingGroupDescription, error) {
+	input := &codeguruprofiler.DescribeProfilingGroupInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeProfilingGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProfilingGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ProfilingGroup, nil
+}
+
+func FindPolicyByProfilingGroupName(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string) (*codeguruprofiler.GetPolicyOutput, error) {
+	input := &codeguruprofiler.GetPolicyInput{
+		ProfilingGroupName: aws.String(name),
+	}
+
+	output, err := conn.GetPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// putAgentPermissions grants the principals permission to run the profiling agent.
+// Any principals previously granted agent permissions are replaced.
+func putAgentPermissions(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string, principals []*string) error {
+	input := &codeguruprofiler.PutPermissionInput{
+		ActionGroup:        aws.String(codeguruprofiler.ActionGroupAgentPermissions),
+		Principals:         principals,
+		ProfilingGroupName: aws.String(name),
+	}
+
+	policy, err := FindPolicyByProfilingGroupName(ctx, conn, name)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return err
+	default:
+		input.RevisionId = policy.RevisionId
+	}
+
+	_, err = conn.PutPermissionWithContext(ctx, input)
+
+	return err
+}
+
+func removeAgentPermissions(ctx context.Context, conn *codeguruprofiler.CodeGuruProfiler, name string) error {
+	policy, err := FindPolicyByProfilingGroupName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.RemovePermissionWithContext(ctx, &codeguruprofiler.RemovePermissionInput{
+		ActionGroup:        aws.String(codeguruprofiler.ActionGroupAgentPermissions),
+		ProfilingGroupName: aws.String(name),
+		RevisionId:         policy.RevisionId,
+	})
+
+	if tfawserr.ErrCodeEquals(err, codeguruprofiler.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+// agentPermissionsPrincipals returns the principals granted the profiling agent actions in a profiling group's resource-based policy.
+func agentPermissionsPrincipals(policy string) ([]string, error) {
+	if policy == "" {
+		return nil, nil
+	}
+
+	var doc struct {
+		Statement []struct {
+			Action    interface{} `json:"Action"`
+			Principal struct {
+				AWS interface{} `json:"AWS"`
+			} `json:"Principal"`
+		} `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+
+	var principals []string
+
+	for _, statement := range doc.Statement {
+		actions := stringOrSlice(statement.Action)
+		if !slices.Contains(actions, "codeguru-profiler:ConfigureAgent") {
+			continue
+		}
+
+		principals = append(principals, stringOrSlice(statement.Principal.AWS)...)
+	}
+
+	return principals, nil
+}
+
+func stringOrSlice(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+func expandAgentOrchestrationConfig(tfMap map[string]interface{}) *codeguruprofiler.AgentOrchestrationConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &codeguruprofiler.AgentOrchestrationConfig{
+		ProfilingEnabled: aws.Bool(tfMap["profiling_enabled"].(bool)),
+	}
+}
+
+func flattenAgentOrchestrationConfig(apiObject *codeguruprofiler.AgentOrchestrationConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"profiling_enabled": aws.BoolValue(apiObject.ProfilingEnabled),
+	}
+}
+
+func expandAgentPermissionsPrincipals(tfList []interface{}) []*string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["principals"].(*schema.Set); ok && v.Len() > 0 {
+		return flex.ExpandStringSet(v)
+	}
+
+	return nil
+}
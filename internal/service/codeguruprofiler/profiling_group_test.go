@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeguruprofiler_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/codeguruprofiler"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeguruprofiler "github.com/hashicorp/terraform-provider-aws/internal/service/codeguruprofiler"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCodeGuruProfilerProfilingGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v codeguruprofiler.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, codeguruprofiler.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codeguruprofiler.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "agent_orchestration_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "agent_orchestration_config.0.profiling_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "codeguru-profiler", regexache.MustCompile(`profilingGroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", codeguruprofiler.ComputePlatformDefault),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerProfilingGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v codeguruprofiler.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, codeguruprofiler.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codeguruprofiler.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodeguruprofiler.ResourceProfilingGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerProfilingGroup_agent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v codeguruprofiler.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, codeguruprofiler.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codeguruprofiler.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_agent(rName, false, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "agent_orchestration_config.0.profiling_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.0.principals.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "agent_permissions.0.principals.*", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", codeguruprofiler.ComputePlatformAwslambda),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfilingGroupConfig_agent(rName, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "agent_orchestration_config.0.profiling_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "1"),
+				),
+			},
+			{
+				Config: testAccProfilingGroupConfig_agent(rName, true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "agent_permissions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeGuruProfilerProfilingGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v codeguruprofiler.ProfilingGroupDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeguruprofiler_profiling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, codeguruprofiler.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, codeguruprofiler.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfilingGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfilingGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfilingGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccProfilingGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfilingGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProfilingGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeguruprofiler_profiling_group" {
+				continue
+			}
+
+			_, err := tfcodeguruprofiler.FindProfilingGroupByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeGuru Profiler Profiling Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProfilingGroupExists(ctx context.Context, n string, v *codeguruprofiler.ProfilingGroupDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeGuruProfilerConn(ctx)
+
+		output, err := tfcodeguruprofiler.FindProfilingGroupByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccProfilingGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccProfilingGroupConfig_agent(rName string, profilingEnabled, hasPermissions bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "lambda.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name             = %[1]q
+  compute_platform = "AWSLambda"
+
+  agent_orchestration_config {
+    profiling_enabled = %[2]t
+  }
+
+  dynamic "agent_permissions" {
+    for_each = %[3]t ? [1] : []
+
+    content {
+      principals = [aws_iam_role.test.arn]
+    }
+  }
+}
+`, rName, profilingEnabled, hasPermissions)
+}
+
+func testAccProfilingGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccProfilingGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_codeguruprofiler_profiling_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
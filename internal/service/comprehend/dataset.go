@@ -0,0 +1,470 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_comprehend_dataset", name="Dataset")
+// @Tags(identifierAttribute="id")
+func ResourceDataset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasetCreate,
+		ReadWithoutTimeout:   resourceDatasetRead,
+		UpdateWithoutTimeout: resourceDatasetUpdate,
+		DeleteWithoutTimeout: resourceDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_s3_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.DatasetType](),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"flywheel_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"input_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"augmented_manifests": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"annotation_data_s3_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"attribute_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"document_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.AugmentedManifestsDocumentTypeFormat](),
+										Default:          types.AugmentedManifestsDocumentTypeFormatPlainTextDocument,
+									},
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"source_documents_s3_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"data_format": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.DatasetDataFormat](),
+							Default:          types.DatasetDataFormatComprehendCsv,
+						},
+						"document_classifier_input_data_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"label_delimiter": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(documentClassifierLabelSeparators(), false),
+									},
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"entity_recognizer_input_data_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"annotations": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_uri": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"documents": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_format": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													ValidateDiagFunc: enum.Validate[types.InputFormat](),
+												},
+												"s3_uri": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"entity_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_uri": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validModelName,
+			},
+			"number_of_documents": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatasetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &comprehend.CreateDatasetInput{
+		DatasetName: aws.String(name),
+		FlywheelArn: aws.String(d.Get("flywheel_arn").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("dataset_type"); ok {
+		input.DatasetType = types.DatasetType(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("input_data_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InputDataConfig = expandDatasetInputDataConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateDataset(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Comprehend Dataset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.DatasetArn))
+
+	if _, err := waitDatasetCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Dataset (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	dataset, err := FindDatasetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Dataset (%s): %s", d.Id(), err)
+	}
+
+	// DescribeDataset() doesn't return the flywheel ARN
+	flywheelARN, err := DatasetParseFlywheelARN(aws.ToString(dataset.DatasetArn))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Dataset (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dataset.DatasetArn)
+	d.Set("dataset_s3_uri", dataset.DatasetS3Uri)
+	d.Set("dataset_type", dataset.DatasetType)
+	d.Set("description", dataset.Description)
+	d.Set("flywheel_arn", flywheelARN)
+	d.Set("name", dataset.DatasetName)
+	d.Set("number_of_documents", dataset.NumberOfDocuments)
+
+	return diags
+}
+
+func resourceDatasetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceDatasetRead(ctx, d, meta)
+}
+
+func resourceDatasetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Comprehend datasets cannot be deleted independently of their flywheel.
+	log.Printf("[WARN] Comprehend Dataset (%s) not deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func FindDatasetByARN(ctx context.Context, conn *comprehend.Client, arn string) (*types.DatasetProperties, error) {
+	input := &comprehend.DescribeDatasetInput{
+		DatasetArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDataset(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DatasetProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DatasetProperties, nil
+}
+
+func statusDataset(ctx context.Context, conn *comprehend.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDatasetByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDatasetCreated(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*types.DatasetProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.DatasetStatusCreating),
+		Target:  enum.Slice(types.DatasetStatusCompleted),
+		Refresh: statusDataset(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DatasetProperties); ok {
+		if output.Status == types.DatasetStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// DatasetParseFlywheelARN returns the ARN of the flywheel that owns the specified dataset.
+func DatasetParseFlywheelARN(arnString string) (string, error) {
+	v, err := arn.Parse(arnString)
+	if err != nil {
+		return "", err
+	}
+	re := regexache.MustCompile(`^(flywheel/[[:alnum:]-]+)/dataset/[[:alnum:]-]+$`)
+	matches := re.FindStringSubmatch(v.Resource)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("unable to parse %q", arnString)
+	}
+	v.Resource = matches[1]
+
+	return v.String(), nil
+}
+
+func expandDatasetInputDataConfig(tfMap map[string]interface{}) *types.DatasetInputDataConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DatasetInputDataConfig{
+		DataFormat: types.DatasetDataFormat(tfMap["data_format"].(string)),
+	}
+
+	if v, ok := tfMap["augmented_manifests"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			item := types.DatasetAugmentedManifestsListItem{
+				AttributeNames: flex.ExpandStringValueList(tfMap["attribute_names"].([]interface{})),
+				DocumentType:   types.AugmentedManifestsDocumentTypeFormat(tfMap["document_type"].(string)),
+				S3Uri:          aws.String(tfMap["s3_uri"].(string)),
+			}
+
+			if v, ok := tfMap["annotation_data_s3_uri"].(string); ok && v != "" {
+				item.AnnotationDataS3Uri = aws.String(v)
+			}
+
+			if v, ok := tfMap["source_documents_s3_uri"].(string); ok && v != "" {
+				item.SourceDocumentsS3Uri = aws.String(v)
+			}
+
+			apiObject.AugmentedManifests = append(apiObject.AugmentedManifests, item)
+		}
+	}
+
+	if v, ok := tfMap["document_classifier_input_data_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.DocumentClassifierInputDataConfig = &types.DatasetDocumentClassifierInputDataConfig{
+			S3Uri: aws.String(tfMap["s3_uri"].(string)),
+		}
+
+		if v, ok := tfMap["label_delimiter"].(string); ok && v != "" {
+			apiObject.DocumentClassifierInputDataConfig.LabelDelimiter = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["entity_recognizer_input_data_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		config := &types.DatasetEntityRecognizerInputDataConfig{}
+
+		if v, ok := tfMap["annotations"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			config.Annotations = &types.DatasetEntityRecognizerAnnotations{
+				S3Uri: aws.String(v[0].(map[string]interface{})["s3_uri"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["documents"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			config.Documents = &types.DatasetEntityRecognizerDocuments{
+				S3Uri: aws.String(tfMap["s3_uri"].(string)),
+			}
+
+			if v, ok := tfMap["input_format"].(string); ok && v != "" {
+				config.Documents.InputFormat = types.InputFormat(v)
+			}
+		}
+
+		if v, ok := tfMap["entity_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			config.EntityList = &types.DatasetEntityRecognizerEntityList{
+				S3Uri: aws.String(v[0].(map[string]interface{})["s3_uri"].(string)),
+			}
+		}
+
+		apiObject.EntityRecognizerInputDataConfig = config
+	}
+
+	return apiObject
+}
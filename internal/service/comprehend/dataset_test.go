@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomprehend "github.com/hashicorp/terraform-provider-aws/internal/service/comprehend"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccComprehendDataset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.DatasetProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_comprehend_dataset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ComprehendEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComprehendEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlywheelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "comprehend", fmt.Sprintf("flywheel/%[1]s/dataset/%[1]s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "dataset_s3_uri"),
+					resource.TestCheckResourceAttr(resourceName, "dataset_type", string(types.DatasetTypeTrain)),
+					resource.TestCheckResourceAttrPair(resourceName, "flywheel_arn", "aws_comprehend_flywheel.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"input_data_config"},
+			},
+		},
+	})
+}
+
+func testAccCheckDatasetExists(ctx context.Context, n string, v *types.DatasetProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComprehendClient(ctx)
+
+		output, err := tfcomprehend.FindDatasetByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDatasetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFlywheelConfig_basic(rName),
+		testAccEntityRecognizerConfig_S3_entityList,
+		fmt.Sprintf(`
+resource "aws_comprehend_dataset" "test" {
+  name         = %[1]q
+  flywheel_arn = aws_comprehend_flywheel.test.arn
+  dataset_type = "TRAIN"
+
+  input_data_config {
+    entity_recognizer_input_data_config {
+      documents {
+        s3_uri = "s3://${aws_s3_bucket.test.bucket}/${aws_s3_object.documents.id}"
+      }
+
+      entity_list {
+        s3_uri = "s3://${aws_s3_bucket.test.bucket}/${aws_s3_object.entities.id}"
+      }
+    }
+  }
+}
+`, rName))
+}
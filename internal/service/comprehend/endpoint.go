@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_comprehend_endpoint", name="Endpoint")
+// @Tags(identifierAttribute="id")
+func ResourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEndpointCreate,
+		ReadWithoutTimeout:   resourceEndpointRead,
+		UpdateWithoutTimeout: resourceEndpointUpdate,
+		DeleteWithoutTimeout: resourceEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_inference_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"desired_inference_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"flywheel_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"flywheel_arn", "model_arn"},
+			},
+			"model_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"flywheel_arn", "model_arn"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validModelName,
+			},
+			"scalable_dimension": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &comprehend.CreateEndpointInput{
+		DesiredInferenceUnits: aws.Int32(int32(d.Get("desired_inference_units").(int))),
+		EndpointName:          aws.String(name),
+		Tags:                  getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("data_access_role_arn"); ok {
+		input.DataAccessRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("flywheel_arn"); ok {
+		input.FlywheelArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("model_arn"); ok {
+		input.ModelArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateEndpoint(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Comprehend Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.EndpointArn))
+
+	if _, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceEndpointRead(ctx, d, meta)...)
+}
+
+func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	endpoint, err := FindEndpointByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	// DescribeEndpoint() doesn't return the endpoint name
+	resourceType, name, err := EndpointParseARN(aws.ToString(endpoint.EndpointArn))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", endpoint.EndpointArn)
+	d.Set("current_inference_units", endpoint.CurrentInferenceUnits)
+	d.Set("data_access_role_arn", endpoint.DataAccessRoleArn)
+	d.Set("desired_inference_units", endpoint.DesiredInferenceUnits)
+	d.Set("flywheel_arn", endpoint.FlywheelArn)
+	d.Set("model_arn", endpoint.ModelArn)
+	d.Set("name", name)
+	d.Set("scalable_dimension", endpointScalableDimension(resourceType))
+
+	return diags
+}
+
+func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &comprehend.UpdateEndpointInput{
+			EndpointArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_access_role_arn") {
+			input.DesiredDataAccessRoleArn = aws.String(d.Get("data_access_role_arn").(string))
+		}
+
+		if d.HasChange("desired_inference_units") {
+			input.DesiredInferenceUnits = aws.Int32(int32(d.Get("desired_inference_units").(int)))
+		}
+
+		if d.HasChange("flywheel_arn") {
+			input.FlywheelArn = aws.String(d.Get("flywheel_arn").(string))
+		}
+
+		if d.HasChange("model_arn") {
+			input.DesiredModelArn = aws.String(d.Get("model_arn").(string))
+		}
+
+		_, err := conn.UpdateEndpoint(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Comprehend Endpoint (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Endpoint (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEndpointRead(ctx, d, meta)...)
+}
+
+func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	log.Printf("[INFO] Deleting Comprehend Endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpoint(ctx, &comprehend.DeleteEndpointInput{
+		EndpointArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Comprehend Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindEndpointByARN(ctx context.Context, conn *comprehend.Client, arn string) (*types.EndpointProperties, error) {
+	input := &comprehend.DescribeEndpointInput{
+		EndpointArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeEndpoint(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EndpointProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EndpointProperties, nil
+}
+
+func statusEndpoint(ctx context.Context, conn *comprehend.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEndpointByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEndpointInService(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*types.EndpointProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.EndpointStatusCreating, types.EndpointStatusUpdating),
+		Target:     enum.Slice(types.EndpointStatusInService),
+		Refresh:    statusEndpoint(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.EndpointProperties); ok {
+		if output.Status == types.EndpointStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointDeleted(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*types.EndpointProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.EndpointStatusInService, types.EndpointStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusEndpoint(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.EndpointProperties); ok {
+		if output.Status == types.EndpointStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// EndpointParseARN returns the resource type (document-classifier-endpoint or entity-recognizer-endpoint)
+// and the name of the specified endpoint.
+func EndpointParseARN(arnString string) (string, string, error) {
+	v, err := arn.Parse(arnString)
+	if err != nil {
+		return "", "", err
+	}
+
+	resourceType, name, found := strings.Cut(v.Resource, "/")
+	if !found || name == "" || !strings.HasSuffix(resourceType, "-endpoint") {
+		return "", "", fmt.Errorf("unable to parse %q", arnString)
+	}
+
+	return resourceType, name, nil
+}
+
+// endpointScalableDimension returns the Application Auto Scaling scalable dimension for the specified endpoint resource type.
+func endpointScalableDimension(resourceType string) string {
+	return fmt.Sprintf("comprehend:%s:DesiredInferenceUnits", resourceType)
+}
@@ -0,0 +1,578 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_comprehend_flywheel", name="Flywheel")
+// @Tags(identifierAttribute="id")
+func ResourceFlywheel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFlywheelCreate,
+		ReadWithoutTimeout:   resourceFlywheelRead,
+		UpdateWithoutTimeout: resourceFlywheelUpdate,
+		DeleteWithoutTimeout: resourceFlywheelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active_model_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"data_lake_s3_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_security_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_lake_kms_key_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: tfkms.DiffSuppressKey,
+							ValidateFunc:     tfkms.ValidateKey,
+						},
+						"model_kms_key_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: tfkms.DiffSuppressKey,
+							ValidateFunc:     tfkms.ValidateKey,
+						},
+						"volume_kms_key_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: tfkms.DiffSuppressKey,
+							ValidateFunc:     tfkms.ValidateKey,
+						},
+						"vpc_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"security_group_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"subnets": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"model_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ModelType](),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validModelName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"task_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"document_classification_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"labels": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"mode": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.DocumentClassifierMode](),
+									},
+								},
+							},
+						},
+						"entity_recognition_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"entity_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 64),
+										},
+									},
+								},
+							},
+						},
+						"language_code": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.LanguageCode](),
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFlywheelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	name := d.Get("name").(string)
+	input := &comprehend.CreateFlywheelInput{
+		DataAccessRoleArn: aws.String(d.Get("data_access_role_arn").(string)),
+		DataLakeS3Uri:     aws.String(d.Get("data_lake_s3_uri").(string)),
+		FlywheelName:      aws.String(name),
+		Tags:              getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("active_model_arn"); ok {
+		input.ActiveModelArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_security_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataSecurityConfig = expandDataSecurityConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("model_type"); ok {
+		input.ModelType = types.ModelType(v.(string))
+	}
+
+	if v, ok := d.GetOk("task_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TaskConfig = expandTaskConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*types.InvalidRequestException](ctx, iamPropagationTimeout, func() (interface{}, error) {
+		return conn.CreateFlywheel(ctx, input)
+	}, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Comprehend Flywheel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*comprehend.CreateFlywheelOutput).FlywheelArn))
+
+	if _, err := waitFlywheelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Flywheel (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceFlywheelRead(ctx, d, meta)...)
+}
+
+func resourceFlywheelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	flywheel, err := FindFlywheelByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Flywheel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Flywheel (%s): %s", d.Id(), err)
+	}
+
+	// DescribeFlywheel() doesn't return the flywheel name
+	name, err := FlywheelParseARN(aws.ToString(flywheel.FlywheelArn))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Flywheel (%s): %s", d.Id(), err)
+	}
+
+	d.Set("active_model_arn", flywheel.ActiveModelArn)
+	d.Set("arn", flywheel.FlywheelArn)
+	d.Set("data_access_role_arn", flywheel.DataAccessRoleArn)
+	if err := d.Set("data_security_config", flattenDataSecurityConfig(flywheel.DataSecurityConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_security_config: %s", err)
+	}
+	d.Set("model_type", flywheel.ModelType)
+	d.Set("name", name)
+	if err := d.Set("task_config", flattenTaskConfig(flywheel.TaskConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting task_config: %s", err)
+	}
+
+	// DescribeFlywheel() returns the data lake location with a flywheel-specific suffix,
+	// so only populate it on import.
+	if v := d.Get("data_lake_s3_uri").(string); v == "" {
+		d.Set("data_lake_s3_uri", flywheel.DataLakeS3Uri)
+	}
+
+	return diags
+}
+
+func resourceFlywheelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &comprehend.UpdateFlywheelInput{
+			FlywheelArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("active_model_arn") {
+			input.ActiveModelArn = aws.String(d.Get("active_model_arn").(string))
+		}
+
+		if d.HasChange("data_access_role_arn") {
+			input.DataAccessRoleArn = aws.String(d.Get("data_access_role_arn").(string))
+		}
+
+		if d.HasChange("data_security_config") {
+			if v, ok := d.GetOk("data_security_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DataSecurityConfig = expandUpdateDataSecurityConfig(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.DataSecurityConfig = &types.UpdateDataSecurityConfig{}
+			}
+		}
+
+		_, err := conn.UpdateFlywheel(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Comprehend Flywheel (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitFlywheelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Flywheel (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFlywheelRead(ctx, d, meta)...)
+}
+
+func resourceFlywheelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient(ctx)
+
+	log.Printf("[INFO] Deleting Comprehend Flywheel: %s", d.Id())
+	_, err := conn.DeleteFlywheel(ctx, &comprehend.DeleteFlywheelInput{
+		FlywheelArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Comprehend Flywheel (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFlywheelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Flywheel (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindFlywheelByARN(ctx context.Context, conn *comprehend.Client, arn string) (*types.FlywheelProperties, error) {
+	input := &comprehend.DescribeFlywheelInput{
+		FlywheelArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeFlywheel(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FlywheelProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.FlywheelProperties, nil
+}
+
+func statusFlywheel(ctx context.Context, conn *comprehend.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFlywheelByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlywheelActive(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*types.FlywheelProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FlywheelStatusCreating, types.FlywheelStatusUpdating),
+		Target:  enum.Slice(types.FlywheelStatusActive),
+		Refresh: statusFlywheel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.FlywheelProperties); ok {
+		if output.Status == types.FlywheelStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFlywheelDeleted(ctx context.Context, conn *comprehend.Client, arn string, timeout time.Duration) (*types.FlywheelProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.FlywheelStatusActive, types.FlywheelStatusDeleting),
+		Target:  []string{},
+		Refresh: statusFlywheel(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.FlywheelProperties); ok {
+		if output.Status == types.FlywheelStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func FlywheelParseARN(arnString string) (string, error) {
+	arn, err := arn.Parse(arnString)
+	if err != nil {
+		return "", err
+	}
+	re := regexache.MustCompile(`^flywheel/([[:alnum:]-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("unable to parse %q", arnString)
+	}
+
+	return matches[1], nil
+}
+
+func expandDataSecurityConfig(tfMap map[string]interface{}) *types.DataSecurityConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DataSecurityConfig{}
+
+	if v, ok := tfMap["data_lake_kms_key_id"].(string); ok && v != "" {
+		apiObject.DataLakeKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["model_kms_key_id"].(string); ok && v != "" {
+		apiObject.ModelKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["volume_kms_key_id"].(string); ok && v != "" {
+		apiObject.VolumeKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_config"].([]interface{}); ok {
+		apiObject.VpcConfig = expandVPCConfig(v)
+	}
+
+	return apiObject
+}
+
+func expandUpdateDataSecurityConfig(tfMap map[string]interface{}) *types.UpdateDataSecurityConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.UpdateDataSecurityConfig{}
+
+	if v, ok := tfMap["model_kms_key_id"].(string); ok && v != "" {
+		apiObject.ModelKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["volume_kms_key_id"].(string); ok && v != "" {
+		apiObject.VolumeKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc_config"].([]interface{}); ok {
+		apiObject.VpcConfig = expandVPCConfig(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataSecurityConfig(apiObject *types.DataSecurityConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"data_lake_kms_key_id": aws.ToString(apiObject.DataLakeKmsKeyId),
+		"model_kms_key_id":     aws.ToString(apiObject.ModelKmsKeyId),
+		"volume_kms_key_id":    aws.ToString(apiObject.VolumeKmsKeyId),
+		"vpc_config":           flattenVPCConfig(apiObject.VpcConfig),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandTaskConfig(tfMap map[string]interface{}) *types.TaskConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.TaskConfig{
+		LanguageCode: types.LanguageCode(tfMap["language_code"].(string)),
+	}
+
+	if v, ok := tfMap["document_classification_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.DocumentClassificationConfig = &types.DocumentClassificationConfig{
+			Mode: types.DocumentClassifierMode(tfMap["mode"].(string)),
+		}
+
+		if v, ok := tfMap["labels"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.DocumentClassificationConfig.Labels = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	if v, ok := tfMap["entity_recognition_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		var entityTypes []types.EntityTypesListItem
+		for _, v := range flex.ExpandStringValueSet(tfMap["entity_types"].(*schema.Set)) {
+			entityTypes = append(entityTypes, types.EntityTypesListItem{
+				Type: aws.String(v),
+			})
+		}
+
+		apiObject.EntityRecognitionConfig = &types.EntityRecognitionConfig{
+			EntityTypes: entityTypes,
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTaskConfig(apiObject *types.TaskConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"language_code": apiObject.LanguageCode,
+	}
+
+	if v := apiObject.DocumentClassificationConfig; v != nil {
+		tfMap["document_classification_config"] = []interface{}{map[string]interface{}{
+			"labels": v.Labels,
+			"mode":   v.Mode,
+		}}
+	}
+
+	if v := apiObject.EntityRecognitionConfig; v != nil {
+		var entityTypes []string
+		for _, v := range v.EntityTypes {
+			entityTypes = append(entityTypes, aws.ToString(v.Type))
+		}
+
+		tfMap["entity_recognition_config"] = []interface{}{map[string]interface{}{
+			"entity_types": entityTypes,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
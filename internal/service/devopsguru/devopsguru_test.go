@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devopsguru_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDevOpsGuru_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"NotificationChannel": {
+			"basic":      testAccNotificationChannel_basic,
+			"disappears": testAccNotificationChannel_disappears,
+			"Filters":    testAccNotificationChannel_filters,
+		},
+		"ResourceCollection": {
+			"basic":          testAccResourceCollection_basic,
+			"disappears":     testAccResourceCollection_disappears,
+			"CloudFormation": testAccResourceCollection_cloudFormation,
+			"Tags":           testAccResourceCollection_tags,
+		},
+		"ServiceIntegration": {
+			"basic": testAccServiceIntegration_basic,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devopsguru
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_devopsguru_notification_channel", name="Notification Channel")
+func ResourceNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNotificationChannelCreate,
+		ReadWithoutTimeout:   resourceNotificationChannelRead,
+		DeleteWithoutTimeout: resourceNotificationChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"filters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"message_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(devopsguru.NotificationMessageType_Values(), false),
+							},
+						},
+						"severities": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(devopsguru.InsightSeverity_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"sns": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNotificationChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+	input := &devopsguru.AddNotificationChannelInput{
+		Config: &devopsguru.NotificationChannelConfig{
+			Sns: expandSnsChannelConfig(d.Get("sns").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("filters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Config.Filters = expandNotificationFilterConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.AddNotificationChannelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DevOps Guru Notification Channel: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return append(diags, resourceNotificationChannelRead(ctx, d, meta)...)
+}
+
+func resourceNotificationChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+	channel, err := FindNotificationChannelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DevOps Guru Notification Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DevOps Guru Notification Channel (%s): %s", d.Id(), err)
+	}
+
+	if v := channel.Config.Filters; v != nil {
+		if err := d.Set("filters", []interface{}{flattenNotificationFilterConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting filters: %s", err)
+		}
+	} else {
+		d.Set("filters", nil)
+	}
+	if v := channel.Config.Sns; v != nil {
+		if err := d.Set("sns", []interface{}{flattenSnsChannelConfig(v)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting sns: %s", err)
+		}
+	} else {
+		d.Set("sns", nil)
+	}
+
+	return diags
+}
+
+func resourceNotificationChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+	log.Printf("[INFO] Deleting DevOps Guru Notification Channel: %s", d.Id())
+	_, err := conn.RemoveNotificationChannelWithContext(ctx, &devopsguru.RemoveNotificationChannelInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, devopsguru.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DevOps Guru Notification Channel (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindNotificationChannelByID(ctx context.Context, conn *devopsguru.DevOpsGuru, id string) (*devopsguru.NotificationChannel, error) {
+	input := &devopsguru.ListNotificationChannelsInput{}
+	var output *devopsguru.NotificationChannel
+
+	err := conn.ListNotificationChannelsPagesWithContext(ctx, input, func(page *devopsguru.ListNotificationChannelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Channels {
+			if v != nil && aws.StringValue(v.Id) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Config == nil {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func expandSnsChannelConfig(tfList []interface{}) *devopsguru.SnsChannelConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &devopsguru.SnsChannelConfig{
+		TopicArn: aws.String(tfMap["topic_arn"].(string)),
+	}
+}
+
+func expandNotificationFilterConfig(tfMap map[string]interface{}) *devopsguru.NotificationFilterConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &devopsguru.NotificationFilterConfig{}
+
+	if v, ok := tfMap["message_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.MessageTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["severities"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Severities = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenSnsChannelConfig(apiObject *devopsguru.SnsChannelConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"topic_arn": aws.StringValue(apiObject.TopicArn),
+	}
+}
+
+func flattenNotificationFilterConfig(apiObject *devopsguru.NotificationFilterConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"message_types": aws.StringValueSlice(apiObject.MessageTypes),
+		"severities":    aws.StringValueSlice(apiObject.Severities),
+	}
+}
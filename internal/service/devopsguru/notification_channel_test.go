@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devopsguru_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdevopsguru "github.com/hashicorp/terraform-provider-aws/internal/service/devopsguru"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccNotificationChannel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v devopsguru.NotificationChannel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devopsguru_notification_channel.test"
+	snsTopicResourceName := "aws_sns_topic.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devopsguru.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "filters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sns.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "sns.0.topic_arn", snsTopicResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNotificationChannel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v devopsguru.NotificationChannel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devopsguru_notification_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devopsguru.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNotificationChannelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdevopsguru.ResourceNotificationChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccNotificationChannel_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v devopsguru.NotificationChannel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devopsguru_notification_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devopsguru.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationChannelConfig_filters(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationChannelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "filters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.message_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filters.0.message_types.*", devopsguru.NotificationMessageTypeNewInsight),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.severities.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filters.0.severities.*", devopsguru.InsightSeverityHigh),
+					resource.TestCheckTypeSetElemAttr(resourceName, "filters.0.severities.*", devopsguru.InsightSeverityMedium),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNotificationChannelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_devopsguru_notification_channel" {
+				continue
+			}
+
+			_, err := tfdevopsguru.FindNotificationChannelByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DevOps Guru Notification Channel %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNotificationChannelExists(ctx context.Context, n string, v *devopsguru.NotificationChannel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+		output, err := tfdevopsguru.FindNotificationChannelByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNotificationChannelConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccNotificationChannelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccNotificationChannelConfig_base(rName), `
+resource "aws_devopsguru_notification_channel" "test" {
+  sns {
+    topic_arn = aws_sns_topic.test.arn
+  }
+}
+`)
+}
+
+func testAccNotificationChannelConfig_filters(rName string) string {
+	return acctest.ConfigCompose(testAccNotificationChannelConfig_base(rName), `
+resource "aws_devopsguru_notification_channel" "test" {
+  sns {
+    topic_arn = aws_sns_topic.test.arn
+  }
+
+  filters {
+    message_types = ["NEW_INSIGHT"]
+    severities    = ["HIGH", "MEDIUM"]
+  }
+}
+`)
+}
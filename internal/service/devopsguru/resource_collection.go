@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devopsguru
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_devopsguru_resource_collection", name="Resource Collection")
+func ResourceResourceCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceCollectionCreate,
+		ReadWithoutTimeout:   resourceResourceCollectionRead,
+		UpdateWithoutTimeout: resourceResourceCollectionUpdate,
+		DeleteWithoutTimeout: resourceResourceCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloudformation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"cloudformation", "tags"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stack_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"cloudformation", "tags"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_boundary_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"tag_values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					devopsguru.ResourceCollectionTypeAwsCloudFormation,
+					devopsguru.ResourceCollectionTypeAwsTags,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceResourceCollectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+	collectionType := d.Get("type").(string)
+	filter := expandUpdateResourceCollectionFilter(d.Get("cloudformation").([]interface{}), d.Get("tags").([]interface{}))
+
+	if err := updateResourceCollection(ctx, conn, devopsguru.UpdateResourceCollectionActionAdd, filter); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DevOps Guru Resource Collection (%s): %s", collectionType, err)
+	}
+
+	d.SetId(collectionType)
+
+	return append(diags, resourceResourceCollectionRead(ctx, d, meta)...)
+}
+
+func resourceResourceCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+	collection, err := FindResourceCollectionByType(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DevOps Guru Resource Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DevOps Guru Resource Collection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("type", d.Id())
+
+	if v := collection.CloudFormation; v != nil && len(v.StackNames) > 0 {
+		if err := d.Set("cloudformation", []interface{}{map[string]interface{}{
+			"stack_names": aws.StringValueSlice(v.StackNames),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting cloudformation: %s", err)
+		}
+	} else {
+		d.Set("cloudformation", nil)
+	}
+
+	if len(collection.Tags) > 0 && collection.Tags[0] != nil {
+		if err := d.Set("tags", []interface{}{map[string]interface{}{
+			"app_boundary_key": aws.StringValue(collection.Tags[0].AppBoundaryKey),
+			"tag_values":       aws.StringValueSlice(collection.Tags[0].TagValues),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+		}
+	} else {
+		d.Set("tags", nil)
+	}
+
+	return diags
+}
+
+func resourceResourceCollectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+	oCFN, nCFN := d.GetChange("cloudformation")
+	oTags, nTags := d.GetChange("tags")
+	oFilter := expandUpdateResourceCollectionFilter(oCFN.([]interface{}), oTags.([]interface{}))
+	nFilter := expandUpdateResourceCollectionFilter(nCFN.([]interface{}), nTags.([]interface{}))
+
+	if add := resourceCollectionFilterDifference(nFilter, oFilter); add != nil {
+		if err := updateResourceCollection(ctx, conn, devopsguru.UpdateResourceCollectionActionAdd, add); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DevOps Guru Resource Collection (%s): %s", d.Id(), err)
+		}
+	}
+
+	if del := resourceCollectionFilterDifference(oFilter, nFilter); del != nil {
+		if err := updateResourceCollection(ctx, conn, devopsguru.UpdateResourceCollectionActionRemove, del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DevOps Guru Resource Collection (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceCollectionRead(ctx, d, meta)...)
+}
+
+func resourceResourceCollectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+	log.Printf("[INFO] Deleting DevOps Guru Resource Collection: %s", d.Id())
+	filter := expandUpdateResourceCollectionFilter(d.Get("cloudformation").([]interface{}), d.Get("tags").([]interface{}))
+
+	if err := updateResourceCollection(ctx, conn, devopsguru.UpdateResourceCollectionActionRemove, filter); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DevOps Guru Resource Collection (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindResourceCollectionByType(ctx context.Context, conn *devopsguru.DevOpsGuru, collectionType string) (*devopsguru.ResourceCollectionFilter, error) {
+	input := &devopsguru.GetResourceCollectionInput{
+		ResourceCollectionType: aws.String(collectionType),
+	}
+	output := &devopsguru.ResourceCollectionFilter{}
+
+	err := conn.GetResourceCollectionPagesWithContext(ctx, input, func(page *devopsguru.GetResourceCollectionOutput, lastPage bool) bool {
+		if page == nil || page.ResourceCollection == nil {
+			return !lastPage
+		}
+
+		if v := page.ResourceCollection.CloudFormation; v != nil {
+			if output.CloudFormation == nil {
+				output.CloudFormation = &devopsguru.CloudFormationCollectionFilter{}
+			}
+			output.CloudFormation.StackNames = append(output.CloudFormation.StackNames, v.StackNames...)
+		}
+
+		output.Tags = append(output.Tags, page.ResourceCollection.Tags...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if (output.CloudFormation == nil || len(output.CloudFormation.StackNames) == 0) && len(output.Tags) == 0 {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func updateResourceCollection(ctx context.Context, conn *devopsguru.DevOpsGuru, action string, filter *devopsguru.UpdateResourceCollectionFilter) error {
+	_, err := conn.UpdateResourceCollectionWithContext(ctx, &devopsguru.UpdateResourceCollectionInput{
+		Action:             aws.String(action),
+		ResourceCollection: filter,
+	})
+
+	return err
+}
+
+func expandUpdateResourceCollectionFilter(cloudFormation, tags []interface{}) *devopsguru.UpdateResourceCollectionFilter {
+	apiObject := &devopsguru.UpdateResourceCollectionFilter{}
+
+	if len(cloudFormation) > 0 && cloudFormation[0] != nil {
+		tfMap := cloudFormation[0].(map[string]interface{})
+
+		apiObject.CloudFormation = &devopsguru.UpdateCloudFormationCollectionFilter{
+			StackNames: flex.ExpandStringSet(tfMap["stack_names"].(*schema.Set)),
+		}
+	}
+
+	if len(tags) > 0 && tags[0] != nil {
+		tfMap := tags[0].(map[string]interface{})
+
+		apiObject.Tags = []*devopsguru.UpdateTagCollectionFilter{{
+			AppBoundaryKey: aws.String(tfMap["app_boundary_key"].(string)),
+			TagValues:      flex.ExpandStringSet(tfMap["tag_values"].(*schema.Set)),
+		}}
+	}
+
+	return apiObject
+}
+
+// resourceCollectionFilterDifference returns the stack names and tag values in a that are not in b.
+// nil is returned if there are none.
+func resourceCollectionFilterDifference(a, b *devopsguru.UpdateResourceCollectionFilter) *devopsguru.UpdateResourceCollectionFilter {
+	difference := func(a, b []*string) []*string {
+		m := make(map[string]bool)
+		for _, v := range b {
+			m[aws.StringValue(v)] = true
+		}
+
+		var s []*string
+		for _, v := range a {
+			if !m[aws.StringValue(v)] {
+				s = append(s, v)
+			}
+		}
+
+		return s
+	}
+
+	apiObject := &devopsguru.UpdateResourceCollectionFilter{}
+	empty := true
+
+	if a.CloudFormation != nil {
+		var stackNames []*string
+		if b.CloudFormation != nil {
+			stackNames = b.CloudFormation.StackNames
+		}
+
+		if v := difference(a.CloudFormation.StackNames, stackNames); len(v) > 0 {
+			apiObject.CloudFormation = &devopsguru.UpdateCloudFormationCollectionFilter{
+				StackNames: v,
+			}
+			empty = false
+		}
+	}
+
+	if len(a.Tags) > 0 {
+		var tagValues []*string
+		if len(b.Tags) > 0 && aws.StringValue(b.Tags[0].AppBoundaryKey) == aws.StringValue(a.Tags[0].AppBoundaryKey) {
+			tagValues = b.Tags[0].TagValues
+		}
+
+		if v := difference(a.Tags[0].TagValues, tagValues); len(v) > 0 {
+			apiObject.Tags = []*devopsguru.UpdateTagCollectionFilter{{
+				AppBoundaryKey: a.Tags[0].AppBoundaryKey,
+				TagValues:      v,
+			}}
+			empty = false
+		}
+	}
+
+	if empty {
+		return nil
+	}
+
+	return apiObject
+}
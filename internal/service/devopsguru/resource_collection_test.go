@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devopsguru_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdevopsguru "github.com/hashicorp/terraform-provider-aws/internal/service/devopsguru"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccResourceCollection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v devopsguru.ResourceCollectionFilter
+	resourceName := "aws_devopsguru_resource_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devopsguru.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceCollectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCollectionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceCollectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation.0.stack_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cloudformation.0.stack_names.*", "*"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", devopsguru.ResourceCollectionTypeAwsCloudFormation),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCollection_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v devopsguru.ResourceCollectionFilter
+	resourceName := "aws_devopsguru_resource_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devopsguru.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceCollectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCollectionConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceCollectionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdevopsguru.ResourceResourceCollection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCollection_cloudFormation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v devopsguru.ResourceCollectionFilter
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_devopsguru_resource_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devopsguru.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceCollectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCollectionConfig_cloudFormation1(rName1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceCollectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation.0.stack_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cloudformation.0.stack_names.*", rName1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceCollectionConfig_cloudFormation2(rName1, rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceCollectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation.0.stack_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cloudformation.0.stack_names.*", rName1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cloudformation.0.stack_names.*", rName2),
+				),
+			},
+			{
+				Config: testAccResourceCollectionConfig_cloudFormation1(rName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceCollectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation.0.stack_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cloudformation.0.stack_names.*", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceCollection_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v devopsguru.ResourceCollectionFilter
+	resourceName := "aws_devopsguru_resource_collection.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devopsguru.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceCollectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCollectionConfig_tags("prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceCollectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudformation.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0.app_boundary_key", "DevOps-Guru-tfacctest"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0.tag_values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.0.tag_values.*", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "type", devopsguru.ResourceCollectionTypeAwsTags),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceCollectionConfig_tags("dev"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceCollectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.0.tag_values.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.0.tag_values.*", "dev"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceCollectionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_devopsguru_resource_collection" {
+				continue
+			}
+
+			_, err := tfdevopsguru.FindResourceCollectionByType(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DevOps Guru Resource Collection %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceCollectionExists(ctx context.Context, n string, v *devopsguru.ResourceCollectionFilter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+		output, err := tfdevopsguru.FindResourceCollectionByType(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccResourceCollectionConfig_basic() string {
+	return `
+resource "aws_devopsguru_resource_collection" "test" {
+  type = "AWS_CLOUD_FORMATION"
+
+  cloudformation {
+    stack_names = ["*"]
+  }
+}
+`
+}
+
+func testAccResourceCollectionConfig_cloudFormation1(stackName1 string) string {
+	return fmt.Sprintf(`
+resource "aws_devopsguru_resource_collection" "test" {
+  type = "AWS_CLOUD_FORMATION"
+
+  cloudformation {
+    stack_names = [%[1]q]
+  }
+}
+`, stackName1)
+}
+
+func testAccResourceCollectionConfig_cloudFormation2(stackName1, stackName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_devopsguru_resource_collection" "test" {
+  type = "AWS_CLOUD_FORMATION"
+
+  cloudformation {
+    stack_names = [%[1]q, %[2]q]
+  }
+}
+`, stackName1, stackName2)
+}
+
+func testAccResourceCollectionConfig_tags(tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_devopsguru_resource_collection" "test" {
+  type = "AWS_TAGS"
+
+  tags {
+    app_boundary_key = "DevOps-Guru-tfacctest"
+    tag_values       = [%[1]q]
+  }
+}
+`, tagValue)
+}
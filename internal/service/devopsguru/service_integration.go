@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devopsguru
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_devopsguru_service_integration", name="Service Integration")
+func ResourceServiceIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceIntegrationPut,
+		ReadWithoutTimeout:   resourceServiceIntegrationRead,
+		UpdateWithoutTimeout: resourceServiceIntegrationPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"logs_anomaly_detection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"opt_in_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(devopsguru.OptInStatus_Values(), false),
+						},
+					},
+				},
+			},
+			"ops_center": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"opt_in_status": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(devopsguru.OptInStatus_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceIntegrationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+	input := &devopsguru.UpdateServiceIntegrationInput{
+		ServiceIntegration: &devopsguru.UpdateServiceIntegrationConfig{},
+	}
+
+	if v, ok := d.GetOk("logs_anomaly_detection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v, ok := v.([]interface{})[0].(map[string]interface{})["opt_in_status"].(string); ok && v != "" {
+			input.ServiceIntegration.LogsAnomalyDetection = &devopsguru.LogsAnomalyDetectionIntegrationConfig{
+				OptInStatus: aws.String(v),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("ops_center"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v, ok := v.([]interface{})[0].(map[string]interface{})["opt_in_status"].(string); ok && v != "" {
+			input.ServiceIntegration.OpsCenter = &devopsguru.OpsCenterIntegrationConfig{
+				OptInStatus: aws.String(v),
+			}
+		}
+	}
+
+	region := meta.(*conns.AWSClient).Region
+
+	if _, err := conn.UpdateServiceIntegrationWithContext(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating DevOps Guru Service Integration (%s): %s", region, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(region)
+	}
+
+	return append(diags, resourceServiceIntegrationRead(ctx, d, meta)...)
+}
+
+func resourceServiceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DevOpsGuruConn(ctx)
+
+	integration, err := FindServiceIntegration(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DevOps Guru Service Integration (%s): %s", d.Id(), err)
+	}
+
+	if v := integration.LogsAnomalyDetection; v != nil {
+		if err := d.Set("logs_anomaly_detection", []interface{}{map[string]interface{}{
+			"opt_in_status": aws.StringValue(v.OptInStatus),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting logs_anomaly_detection: %s", err)
+		}
+	} else {
+		d.Set("logs_anomaly_detection", nil)
+	}
+	if v := integration.OpsCenter; v != nil {
+		if err := d.Set("ops_center", []interface{}{map[string]interface{}{
+			"opt_in_status": aws.StringValue(v.OptInStatus),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting ops_center: %s", err)
+		}
+	} else {
+		d.Set("ops_center", nil)
+	}
+
+	return diags
+}
+
+func FindServiceIntegration(ctx context.Context, conn *devopsguru.DevOpsGuru) (*devopsguru.ServiceIntegrationConfig, error) {
+	input := &devopsguru.DescribeServiceIntegrationInput{}
+
+	output, err := conn.DescribeServiceIntegrationWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ServiceIntegration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ServiceIntegration, nil
+}
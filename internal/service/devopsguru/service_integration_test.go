@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package devopsguru_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/devopsguru"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccServiceIntegration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_devopsguru_service_integration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, devopsguru.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, devopsguru.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceIntegrationConfig_basic(devopsguru.OptInStatusEnabled, devopsguru.OptInStatusDisabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logs_anomaly_detection.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logs_anomaly_detection.0.opt_in_status", devopsguru.OptInStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "ops_center.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ops_center.0.opt_in_status", devopsguru.OptInStatusDisabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceIntegrationConfig_basic(devopsguru.OptInStatusDisabled, devopsguru.OptInStatusEnabled),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logs_anomaly_detection.0.opt_in_status", devopsguru.OptInStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "ops_center.0.opt_in_status", devopsguru.OptInStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceIntegrationConfig_basic(logsAnomalyDetection, opsCenter string) string {
+	return fmt.Sprintf(`
+resource "aws_devopsguru_service_integration" "test" {
+  logs_anomaly_detection {
+    opt_in_status = %[1]q
+  }
+
+  ops_center {
+    opt_in_status = %[2]q
+  }
+}
+`, logsAnomalyDetection, opsCenter)
+}
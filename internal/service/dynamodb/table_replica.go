@@ -30,7 +30,8 @@ import (
 )
 
 const (
-	ResNameTableReplica = "Table Replica"
+	ResNameTableReplica    = "Table Replica"
+	globalTableVersion2019 = "2019.11.21"
 )
 
 // @SDKResource("aws_dynamodb_table_replica", name="Table Replica")
@@ -62,7 +63,23 @@ func ResourceTableReplica() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			// global_secondary_index read capacity override can be set but not return by aws atm either through main/replica nor directly
+			"global_secondary_index": { // through main table
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"read_capacity_override": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 			"global_table_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -116,6 +133,21 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 
 	conn = dynamodb.New(session) // now main table region
 
+	tableName, err := TableNameFromARN(d.Get("global_table_arn").(string))
+	if err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTableReplica, d.Get("global_table_arn").(string), err)
+	}
+
+	table, err := FindTableByName(ctx, conn, tableName)
+	if err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTableReplica, d.Get("global_table_arn").(string), err)
+	}
+
+	// Replicas of version 2017.11.29 global tables are managed with aws_dynamodb_global_table.
+	if v := aws.StringValue(table.GlobalTableVersion); v != "" && v != globalTableVersion2019 {
+		return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTableReplica, d.Get("global_table_arn").(string), fmt.Errorf("global table version %s is not supported, must be %s", v, globalTableVersion2019))
+	}
+
 	var replicaInput = &dynamodb.CreateReplicationGroupMemberAction{}
 
 	replicaInput.RegionName = aws.String(replicaRegion)
@@ -128,9 +160,8 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 		replicaInput.TableClassOverride = aws.String(v.(string))
 	}
 
-	tableName, err := TableNameFromARN(d.Get("global_table_arn").(string))
-	if err != nil {
-		return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTableReplica, d.Get("global_table_arn").(string), err)
+	if v, ok := d.GetOk("global_secondary_index"); ok && v.(*schema.Set).Len() > 0 {
+		replicaInput.GlobalSecondaryIndexes = expandReplicaGlobalSecondaryIndexes(v.(*schema.Set).List())
 	}
 
 	input := &dynamodb.UpdateTableInput{
@@ -200,6 +231,9 @@ func resourceTableReplicaRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.DiagError(names.DynamoDB, create.ErrActionReading, ResNameTableReplica, d.Id(), err)
 	}
 
+	// global_table_arn is only missing from state on import.
+	importing := d.Get("global_table_arn").(string) == ""
+
 	globalTableARN := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -272,6 +306,10 @@ func resourceTableReplicaRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("table_class_override", nil)
 	}
 
+	if err := d.Set("global_secondary_index", flattenReplicaGlobalSecondaryIndexDescriptions(replica.GlobalSecondaryIndexes, d.Get("global_secondary_index").(*schema.Set).List(), importing)); err != nil {
+		return create.DiagError(names.DynamoDB, create.ErrActionReading, ResNameTableReplica, d.Id(), err)
+	}
+
 	return append(diags, resourceTableReplicaReadReplica(ctx, d, meta)...)
 }
 
@@ -386,6 +424,15 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("global_secondary_index") && !d.IsNewResource() {
+		// The API has no way to remove an override, so indexes or overrides that are
+		// no longer configured are left unchanged.
+		if v := expandReplicaGlobalSecondaryIndexes(d.Get("global_secondary_index").(*schema.Set).List()); len(v) > 0 {
+			viaMainChanges = true
+			viaMainInput.GlobalSecondaryIndexes = v
+		}
+	}
+
 	if viaMainChanges {
 		input := &dynamodb.UpdateTableInput{
 			ReplicaUpdates: []*dynamodb.ReplicationGroupUpdate{{
@@ -541,3 +588,71 @@ func FilterReplicasByRegion(replicas []*dynamodb.ReplicaDescription, region stri
 
 	return nil, errors.New("replica not found")
 }
+
+func expandReplicaGlobalSecondaryIndexes(tfList []interface{}) []*dynamodb.ReplicaGlobalSecondaryIndex {
+	var apiObjects []*dynamodb.ReplicaGlobalSecondaryIndex
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &dynamodb.ReplicaGlobalSecondaryIndex{
+			IndexName: aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["read_capacity_override"].(int); ok && v != 0 {
+			apiObject.ProvisionedThroughputOverride = &dynamodb.ProvisionedThroughputOverride{
+				ReadCapacityUnits: aws.Int64(int64(v)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenReplicaGlobalSecondaryIndexDescriptions flattens the index settings of a replica.
+// Only the indexes in configured are returned and their read capacity override is only
+// returned if it's configured, as overrides can't be removed. On import, all indexes
+// with an override are returned.
+func flattenReplicaGlobalSecondaryIndexDescriptions(apiObjects []*dynamodb.ReplicaGlobalSecondaryIndexDescription, configured []interface{}, importing bool) []interface{} {
+	overrides := make(map[string]int)
+
+	for _, tfMapRaw := range configured {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			overrides[tfMap[names.AttrName].(string)] = tfMap["read_capacity_override"].(int)
+		}
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		name := aws.StringValue(apiObject.IndexName)
+		override, ok := overrides[name]
+
+		if !importing && !ok {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrName: name,
+		}
+
+		if v := apiObject.ProvisionedThroughputOverride; v != nil && (importing || override != 0) {
+			tfMap["read_capacity_override"] = aws.Int64Value(v.ReadCapacityUnits)
+		} else if importing {
+			continue
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
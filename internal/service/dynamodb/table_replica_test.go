@@ -346,6 +346,72 @@ func TestAccDynamoDBTableReplica_keys(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTableReplica_globalSecondaryIndex(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_dynamodb_table_replica.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckMultipleRegion(t, 2) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 2),
+		CheckDestroy:             testAccCheckTableReplicaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaConfig_globalSecondaryIndex(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableReplicaExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"name":                   "TitleIndex",
+						"read_capacity_override": "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableReplicaConfig_globalSecondaryIndex(rName, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableReplicaExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"name":                   "TitleIndex",
+						"read_capacity_override": "3",
+					}),
+				),
+			},
+			{
+				// Removing the override leaves it unchanged on the replica, without a diff.
+				Config: testAccTableReplicaConfig_globalSecondaryIndex(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableReplicaExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"name":                   "TitleIndex",
+						"read_capacity_override": "0",
+					}),
+				),
+			},
+			{
+				// Removing the last block also converges, rather than adopting the override again.
+				Config: testAccTableReplicaConfig_globalSecondaryIndex(rName, -1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableReplicaExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_secondary_index.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTableReplicaDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn(ctx)
@@ -797,3 +863,123 @@ resource "aws_dynamodb_table_replica" "test" {
 }
 `, rName, key))
 }
+
+// testAccTableReplicaConfig_globalSecondaryIndex configures the replica's TitleIndex with the specified
+// read capacity override, or without one if readCapacity is 0. If readCapacity is negative, the
+// global_secondary_index block is omitted.
+func testAccTableReplicaConfig_globalSecondaryIndex(rName string, readCapacity int) string {
+	var globalSecondaryIndex string
+	if readCapacity > 0 {
+		globalSecondaryIndex = fmt.Sprintf(`
+  global_secondary_index {
+    name                   = "TitleIndex"
+    read_capacity_override = %d
+  }
+`, readCapacity)
+	} else if readCapacity == 0 {
+		globalSecondaryIndex = `
+  global_secondary_index {
+    name = "TitleIndex"
+  }
+`
+	}
+
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(2),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  provider         = awsalternate
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PROVISIONED"
+  read_capacity    = 1
+  write_capacity   = 1
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "Title"
+    type = "S"
+  }
+
+  global_secondary_index {
+    name            = "TitleIndex"
+    hash_key        = "Title"
+    projection_type = "ALL"
+    read_capacity   = 1
+    write_capacity  = 1
+  }
+
+  lifecycle {
+    ignore_changes = [replica, write_capacity, global_secondary_index]
+  }
+}
+
+# Replicas of provisioned tables require write capacity auto scaling.
+resource "aws_appautoscaling_target" "table" {
+  provider           = awsalternate
+  max_capacity       = 2
+  min_capacity       = 1
+  resource_id        = "table/${aws_dynamodb_table.test.name}"
+  scalable_dimension = "dynamodb:table:WriteCapacityUnits"
+  service_namespace  = "dynamodb"
+}
+
+resource "aws_appautoscaling_policy" "table" {
+  provider           = awsalternate
+  name               = "%[1]s-table"
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = aws_appautoscaling_target.table.resource_id
+  scalable_dimension = aws_appautoscaling_target.table.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.table.service_namespace
+
+  target_tracking_scaling_policy_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "DynamoDBWriteCapacityUtilization"
+    }
+
+    target_value = 70
+  }
+}
+
+resource "aws_appautoscaling_target" "index" {
+  provider           = awsalternate
+  max_capacity       = 2
+  min_capacity       = 1
+  resource_id        = "table/${aws_dynamodb_table.test.name}/index/TitleIndex"
+  scalable_dimension = "dynamodb:index:WriteCapacityUnits"
+  service_namespace  = "dynamodb"
+}
+
+resource "aws_appautoscaling_policy" "index" {
+  provider           = awsalternate
+  name               = "%[1]s-index"
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = aws_appautoscaling_target.index.resource_id
+  scalable_dimension = aws_appautoscaling_target.index.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.index.service_namespace
+
+  target_tracking_scaling_policy_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "DynamoDBWriteCapacityUtilization"
+    }
+
+    target_value = 70
+  }
+}
+
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn = aws_dynamodb_table.test.arn
+%[2]s
+  depends_on = [
+    aws_appautoscaling_policy.table,
+    aws_appautoscaling_policy.index,
+  ]
+}
+`, rName, globalSecondaryIndex))
+}
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -25,12 +27,23 @@ func ResourceEBSDefaultKMSKey() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceEBSDefaultKMSKeyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
+			"key_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: tfkms.ValidateKeyAlias,
+				ExactlyOneOf: []string{"key_alias", "key_arn"},
+			},
 			"key_arn": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"key_alias", "key_arn"},
 			},
 		},
 	}
@@ -40,8 +53,19 @@ func resourceEBSDefaultKMSKeyCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
+	keyARN := d.Get("key_arn").(string)
+
+	if v, ok := d.GetOk("key_alias"); ok {
+		var err error
+		keyARN, err = findKeyARNByAlias(ctx, meta, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EBS default KMS key: %s", err)
+		}
+	}
+
 	resp, err := conn.ModifyEbsDefaultKmsKeyIdWithContext(ctx, &ec2.ModifyEbsDefaultKmsKeyIdInput{
-		KmsKeyId: aws.String(d.Get("key_arn").(string)),
+		KmsKeyId: aws.String(keyARN),
 	})
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating EBS default KMS key: %s", err)
@@ -77,3 +101,48 @@ func resourceEBSDefaultKMSKeyDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+// resourceEBSDefaultKMSKeyCustomizeDiff resolves key_alias to the ARN of its target key so that
+// a change of the alias target, or of the default key outside Terraform, shows up as a diff.
+func resourceEBSDefaultKMSKeyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("key_alias") {
+		return nil
+	}
+
+	alias := diff.Get("key_alias").(string)
+	if alias == "" {
+		return nil
+	}
+
+	keyARN, err := findKeyARNByAlias(ctx, meta, alias)
+
+	if err != nil {
+		return err
+	}
+
+	if diff.Id() == "" {
+		return diff.SetNew("key_arn", keyARN)
+	}
+
+	if o, _ := diff.GetChange("key_arn"); o.(string) != keyARN {
+		if err := diff.SetNew("key_arn", keyARN); err != nil {
+			return err
+		}
+
+		return diff.ForceNew("key_arn")
+	}
+
+	return nil
+}
+
+func findKeyARNByAlias(ctx context.Context, meta interface{}, alias string) (string, error) {
+	conn := meta.(*conns.AWSClient).KMSConn(ctx)
+
+	key, err := tfkms.FindKeyByID(ctx, conn, alias)
+
+	if err != nil {
+		return "", fmt.Errorf("reading KMS Key for alias (%s): %w", alias, err)
+	}
+
+	return aws.StringValue(key.Arn), nil
+}
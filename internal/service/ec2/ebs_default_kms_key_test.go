@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -45,6 +46,45 @@ func TestAccEC2EBSDefaultKMSKey_basic(t *testing.T) {
 	})
 }
 
+func TestAccEC2EBSDefaultKMSKey_alias(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ebs_default_kms_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEBSDefaultKMSKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSDefaultKMSKeyConfig_alias(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSDefaultKMSKey(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_alias", "aws_kms_alias.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_kms_key.test1", "arn"),
+				),
+			},
+			{
+				// The alias target changes after the default key has been planned.
+				Config: testAccEBSDefaultKMSKeyConfig_alias(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSDefaultKMSKey(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_kms_key.test1", "arn"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccEBSDefaultKMSKeyConfig_alias(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEBSDefaultKMSKey(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_kms_key.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckEBSDefaultKMSKeyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		arn, err := testAccEBSManagedDefaultKey(ctx)
@@ -132,3 +172,24 @@ resource "aws_ebs_default_kms_key" "test" {
   key_arn = aws_kms_key.test.arn
 }
 `
+
+func testAccEBSDefaultKMSKeyConfig_alias(rName, targetKey string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test1" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "test2" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_alias" "test" {
+  name          = "alias/%[1]s"
+  target_key_id = aws_kms_key.%[2]s.key_id
+}
+
+resource "aws_ebs_default_kms_key" "test" {
+  key_alias = aws_kms_alias.test.name
+}
+`, rName, targetKey)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// imagesCacheTTL bounds how long DescribeImages results are reused.
+// Each Terraform operation configures a new *conns.AWSClient, so this only matters for long-running operations.
+const imagesCacheTTL = 5 * time.Minute
+
+// imagesCaches holds an *imagesCache per *conns.AWSClient, so that results are only shared
+// within a provider configuration and never across credentials, endpoints or operations.
+// Large configurations often read the same AMI from many data sources (e.g. one per module instance),
+// and each read pages through DescribeImages from scratch.
+var imagesCaches sync.Map
+
+// findImagesCached is FindImages with results shared between identical lookups made
+// with the same provider configuration. Concurrent identical lookups wait for a single request.
+// Errors are not cached.
+func findImagesCached(ctx context.Context, client *conns.AWSClient, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+	conn := client.EC2Conn(ctx)
+
+	v, _ := imagesCaches.LoadOrStore(client, newImagesCache(func(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+		return FindImages(ctx, conn, input)
+	}))
+
+	return v.(*imagesCache).find(ctx, input)
+}
+
+type imagesCacheEntry struct {
+	done    chan struct{}
+	images  []*ec2.Image
+	err     error
+	expires time.Time
+	// cancelled is set if the context of the caller that made the request was done.
+	cancelled bool
+}
+
+// imagesCache shares DescribeImages results between identical lookups.
+type imagesCache struct {
+	describe func(context.Context, *ec2.DescribeImagesInput) ([]*ec2.Image, error)
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*imagesCacheEntry
+}
+
+func newImagesCache(describe func(context.Context, *ec2.DescribeImagesInput) ([]*ec2.Image, error)) *imagesCache {
+	return &imagesCache{
+		describe: describe,
+		now:      time.Now,
+		entries:  make(map[string]*imagesCacheEntry),
+	}
+}
+
+func (c *imagesCache) find(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+	key := input.String()
+
+	for {
+		c.mu.Lock()
+		entry, ok := c.entries[key]
+		if ok && entry.isExpired(c.now()) {
+			delete(c.entries, key)
+			ok = false
+		}
+		if !ok {
+			entry = &imagesCacheEntry{done: make(chan struct{})}
+			c.entries[key] = entry
+		}
+		c.mu.Unlock()
+
+		if !ok {
+			entry.images, entry.err = c.describe(ctx, input)
+			entry.expires = c.now().Add(imagesCacheTTL)
+			entry.cancelled = ctx.Err() != nil
+
+			if entry.err != nil {
+				c.mu.Lock()
+				if c.entries[key] == entry {
+					delete(c.entries, key)
+				}
+				c.mu.Unlock()
+			}
+
+			close(entry.done)
+
+			if entry.err != nil {
+				return nil, entry.err
+			}
+
+			return copyImages(entry.images), nil
+		}
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if entry.err != nil {
+			// The request was made with another caller's context. Retry with ours if that caller was cancelled.
+			if entry.cancelled && ctx.Err() == nil {
+				continue
+			}
+
+			return nil, entry.err
+		}
+
+		return copyImages(entry.images), nil
+	}
+}
+
+func (e *imagesCacheEntry) isExpired(now time.Time) bool {
+	select {
+	case <-e.done:
+		return !now.Before(e.expires)
+	default:
+		return false
+	}
+}
+
+// copyImages returns a copy of images, as callers may sort or filter the result in place.
+func copyImages(images []*ec2.Image) []*ec2.Image {
+	return append([]*ec2.Image(nil), images...)
+}
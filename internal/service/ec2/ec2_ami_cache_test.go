@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestImagesCache_concurrent(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	c := newImagesCache(func(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+		calls.Add(1)
+		<-release
+		return []*ec2.Image{{ImageId: aws.String("ami-1")}}, nil
+	})
+	input := &ec2.DescribeImagesInput{Owners: aws.StringSlice([]string{"self"})}
+
+	const n = 10
+	var wg sync.WaitGroup
+	results := make([][]*ec2.Image, n)
+	errs := make([]error, n)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = c.find(context.Background(), input)
+		}(i)
+	}
+
+	// Wait for the first request to start before letting it complete.
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatalf("find %d: %s", i, errs[i])
+		}
+		if len(results[i]) != 1 || aws.StringValue(results[i][0].ImageId) != "ami-1" {
+			t.Errorf("find %d = %v", i, results[i])
+		}
+	}
+
+	// Results are copies.
+	results[0][0] = nil
+	if results[1][0] == nil {
+		t.Error("results share a backing array")
+	}
+}
+
+func TestImagesCache_differentInputs(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	c := newImagesCache(func(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+		calls.Add(1)
+		return nil, nil
+	})
+
+	for _, owner := range []string{"self", "amazon", "self"} {
+		if _, err := c.find(context.Background(), &ec2.DescribeImagesInput{Owners: aws.StringSlice([]string{owner})}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
+
+func TestImagesCache_errorsNotCached(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	c := newImagesCache(func(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+		if calls.Add(1) == 1 {
+			return nil, errors.New("throttled")
+		}
+		return []*ec2.Image{{ImageId: aws.String("ami-1")}}, nil
+	})
+	input := &ec2.DescribeImagesInput{}
+
+	if _, err := c.find(context.Background(), input); err == nil {
+		t.Fatal("expected error")
+	}
+
+	images, err := c.find(context.Background(), input)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 1 {
+		t.Errorf("images = %v", images)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
+
+func TestImagesCache_expiry(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	c := newImagesCache(func(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+		calls.Add(1)
+		return nil, nil
+	})
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	input := &ec2.DescribeImagesInput{}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.find(context.Background(), input); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("calls before expiry = %d, want 1", got)
+	}
+
+	now = now.Add(imagesCacheTTL)
+
+	if _, err := c.find(context.Background(), input); err != nil {
+		t.Fatal(err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("calls after expiry = %d, want 2", got)
+	}
+}
+
+func TestImagesCache_firstCallerCancelled(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	started := make(chan struct{})
+	c := newImagesCache(func(ctx context.Context, input *ec2.DescribeImagesInput) ([]*ec2.Image, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return []*ec2.Image{{ImageId: aws.String("ami-1")}}, nil
+	})
+	input := &ec2.DescribeImagesInput{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := c.find(ctx, input)
+		firstErr <- err
+	}()
+	<-started
+
+	secondResult := make(chan []*ec2.Image, 1)
+	secondErr := make(chan error, 1)
+	go func() {
+		images, err := c.find(context.Background(), input)
+		secondResult <- images
+		secondErr <- err
+	}()
+
+	// Give the second caller a chance to start waiting on the first request.
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("first caller error = %v, want %s", err, context.Canceled)
+	}
+
+	images := <-secondResult
+	if err := <-secondErr; err != nil {
+		t.Fatalf("second caller error = %s", err)
+	}
+	if len(images) != 1 {
+		t.Errorf("second caller images = %v", images)
+	}
+}
@@ -81,6 +81,11 @@ func DataSourceAMI() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deprecation_window_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -232,7 +237,6 @@ func DataSourceAMI() *schema.Resource {
 
 func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	input := &ec2.DescribeImagesInput{
@@ -251,7 +255,7 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.Owners = flex.ExpandStringList(v.([]interface{}))
 	}
 
-	images, err := FindImages(ctx, conn, input)
+	images, err := findImagesCached(ctx, meta.(*conns.AWSClient), input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 AMIs: %s", err)
@@ -296,6 +300,14 @@ func dataSourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	image := filteredImages[0]
 
+	if v, ok := d.GetOk("deprecation_window_days"); ok {
+		window := v.(int)
+
+		if deprecationTime, err := time.Parse(time.RFC3339, aws.StringValue(image.DeprecationTime)); err == nil && time.Until(deprecationTime) < time.Duration(window)*24*time.Hour {
+			return sdkdiag.AppendErrorf(diags, "EC2 AMI (%s) is deprecated or scheduled for deprecation within %d days (%s)", aws.StringValue(image.ImageId), window, aws.StringValue(image.DeprecationTime))
+		}
+	}
+
 	d.SetId(aws.StringValue(image.ImageId))
 	d.Set("architecture", image.Architecture)
 	imageArn := arn.ARN{
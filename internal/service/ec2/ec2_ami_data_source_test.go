@@ -4,6 +4,7 @@
 package ec2_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -176,6 +177,31 @@ func TestAccEC2AMIDataSource_localNameFilter(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIDataSource_deprecationWindowDays(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_ami.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIDataSourceConfig_deprecationWindowDays(1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(datasourceName, "image_id", regexache.MustCompile("^ami-")),
+					resource.TestCheckResourceAttrSet(datasourceName, "deprecation_time"),
+				),
+			},
+			{
+				// Amazon-owned AMIs are scheduled for deprecation well within 100 years.
+				Config:      testAccAMIDataSourceConfig_deprecationWindowDays(36500),
+				ExpectError: regexache.MustCompile(`scheduled for deprecation within 36500 days`),
+			},
+		},
+	})
+}
+
 func TestAccEC2AMIDataSource_gp3BlockDevice(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ami.test"
@@ -303,6 +329,22 @@ data "aws_ami" "test" {
 }
 `
 
+func testAccAMIDataSourceConfig_deprecationWindowDays(days int) string {
+	return fmt.Sprintf(`
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn2-ami-hvm-*-x86_64-gp2"]
+  }
+
+  deprecation_window_days = %[1]d
+}
+`, days)
+}
+
 func testAccAMIDataSourceConfig_gp3BlockDevice(rName string) string {
 	return acctest.ConfigCompose(
 		testAccAMIConfig_gp3BlockDevice(rName),
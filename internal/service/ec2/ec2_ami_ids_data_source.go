@@ -72,7 +72,6 @@ func DataSourceAMIIDs() *schema.Resource {
 
 func dataSourceAMIIDsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
 	input := &ec2.DescribeImagesInput{
 		IncludeDeprecated: aws.Bool(d.Get("include_deprecated").(bool)),
@@ -87,7 +86,7 @@ func dataSourceAMIIDsRead(ctx context.Context, d *schema.ResourceData, meta inte
 		input.Filters = BuildCustomFilterList(v.(*schema.Set))
 	}
 
-	images, err := FindImages(ctx, conn, input)
+	images, err := findImagesCached(ctx, meta.(*conns.AWSClient), input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading EC2 AMIs: %s", err)
@@ -62,6 +62,12 @@ func ResourceHost() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"host_maintenance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.HostMaintenance_Values(), false),
+			},
 			"host_recovery": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -110,6 +116,10 @@ func resourceHostCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.AssetIds = aws.StringSlice([]string{v.(string)})
 	}
 
+	if v, ok := d.GetOk("host_maintenance"); ok {
+		input.HostMaintenance = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("instance_family"); ok {
 		input.InstanceFamily = aws.String(v.(string))
 	}
@@ -164,6 +174,7 @@ func resourceHostRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set("asset_id", host.AssetId)
 	d.Set("auto_placement", host.AutoPlacement)
 	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_maintenance", host.HostMaintenance)
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set("instance_type", host.HostProperties.InstanceType)
@@ -188,6 +199,10 @@ func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.AutoPlacement = aws.String(d.Get("auto_placement").(string))
 		}
 
+		if d.HasChange("host_maintenance") {
+			input.HostMaintenance = aws.String(d.Get("host_maintenance").(string))
+		}
+
 		if d.HasChange("host_recovery") {
 			input.HostRecovery = aws.String(d.Get("host_recovery").(string))
 		}
@@ -55,6 +55,10 @@ func DataSourceHost() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"host_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"host_recovery": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -127,6 +131,7 @@ func dataSourceHostRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("availability_zone", host.AvailabilityZone)
 	d.Set("cores", host.HostProperties.Cores)
 	d.Set("host_id", host.HostId)
+	d.Set("host_maintenance", host.HostMaintenance)
 	d.Set("host_recovery", host.HostRecovery)
 	d.Set("instance_family", host.HostProperties.InstanceFamily)
 	d.Set("instance_type", host.HostProperties.InstanceType)
@@ -5,16 +5,19 @@ package ec2
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -800,6 +803,10 @@ func ResourceInstance() *schema.Resource {
 						(old == "" && new == "da39a3ee5e6b4b0d3255bfef95601890afd80709") {
 						return true
 					}
+					// State written by provider versions before 5.15.0 holds the hash of the raw (decoded) value.
+					if v := d.GetRawConfig().GetAttr("user_data"); v.IsKnown() && !v.IsNull() && old == legacyUserDataHashSum(v.AsString()) {
+						return true
+					}
 					return false
 				},
 				StateFunc: func(v interface{}) string {
@@ -817,6 +824,9 @@ func ResourceInstance() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"user_data"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return userDataEquivalent(old, new)
+				},
 				ValidateFunc: func(v interface{}, name string) (warns []string, errs []error) {
 					s := v.(string)
 					if !verify.IsBase64Encoded([]byte(s)) {
@@ -844,6 +854,7 @@ func ResourceInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			resourceInstanceIPv6OnlySubnetCustomizeDiff,
 			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				_, ok := diff.GetOk("launch_template")
 
@@ -925,6 +936,69 @@ func throughputDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 	return strings.ToLower(v) != ec2.VolumeTypeGp3 && new == "0"
 }
 
+// resourceInstanceIPv6OnlySubnetCustomizeDiff validates at plan time that an instance launched into an
+// existing IPv6-only subnet uses a Nitro-based instance type and is not assigned a public IPv4 address.
+// The validation is best effort: if the subnet or instance type can't be read, for example because the
+// caller lacks ec2:DescribeSubnets or ec2:DescribeInstanceTypes, it is skipped and the plan proceeds.
+func resourceInstanceIPv6OnlySubnetCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("instance_type") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("subnet_id") || !diff.NewValueKnown("instance_type") {
+		return nil
+	}
+
+	subnetID, instanceType := diff.Get("subnet_id").(string), diff.Get("instance_type").(string)
+
+	if subnetID == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	subnet, err := FindSubnetByID(ctx, conn, subnetID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Skipping IPv6-only subnet validation, reading EC2 Subnet (%s): %s", subnetID, err)
+		return nil
+	}
+
+	if !aws.BoolValue(subnet.Ipv6Native) {
+		return nil
+	}
+
+	if diff.Get("associate_public_ip_address").(bool) {
+		return fmt.Errorf(`"associate_public_ip_address" cannot be true for an instance in IPv6-only subnet (%s)`, subnetID)
+	}
+
+	if instanceType == "" {
+		return nil
+	}
+
+	instanceTypeInfo, err := FindInstanceTypeByName(ctx, conn, instanceType)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Skipping IPv6-only subnet validation, reading EC2 Instance Type (%s): %s", instanceType, err)
+		return nil
+	}
+
+	// Bare metal instance types report no hypervisor.
+	if v := aws.StringValue(instanceTypeInfo.Hypervisor); v != ec2.InstanceTypeHypervisorNitro && !aws.BoolValue(instanceTypeInfo.BareMetal) {
+		return fmt.Errorf("instance type (%s) is not supported in IPv6-only subnet (%s): only Nitro-based instance types are supported", instanceType, subnetID)
+	}
+
+	return nil
+}
+
 func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
@@ -3014,9 +3088,12 @@ func terminateInstance(ctx context.Context, conn *ec2.EC2, id string, timeout ti
 }
 
 func userDataHashSum(user_data string) string {
-	// Check whether the user_data is not Base64 encoded.
-	// Always calculate hash of base64 decoded value since we
-	// check against double-encoding when setting it
+	hash := sha1.Sum(normalizeUserData(user_data))
+	return hex.EncodeToString(hash[:])
+}
+
+// legacyUserDataHashSum returns the hash of the base64 decoded, but otherwise unnormalized, user data.
+func legacyUserDataHashSum(user_data string) string {
 	v, base64DecodeError := base64.StdEncoding.DecodeString(user_data)
 	if base64DecodeError != nil {
 		v = []byte(user_data)
@@ -3026,6 +3103,53 @@ func userDataHashSum(user_data string) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// userDataEquivalent returns whether two user data values have the same normalized content.
+func userDataEquivalent(old, new string) bool {
+	return bytes.Equal(normalizeUserData(old), normalizeUserData(new))
+}
+
+// userDataMIMEBoundary is used in place of multi-part MIME boundaries, which are often randomly generated.
+const userDataMIMEBoundary = "MIMEBOUNDARY"
+
+// normalizeUserData returns the canonical form of user data used for comparison.
+// Base64 and gzip encodings are removed and, for text content, line endings and
+// trailing whitespace are normalized and any multi-part MIME (e.g. cloud-init) boundary is replaced.
+func normalizeUserData(user_data string) []byte {
+	// Check whether the user_data is not Base64 encoded.
+	// Always normalize the base64 decoded value since we
+	// check against double-encoding when setting it
+	v, base64DecodeError := base64.StdEncoding.DecodeString(user_data)
+	if base64DecodeError != nil {
+		v = []byte(user_data)
+	}
+
+	// cloud-init accepts gzip compressed user data.
+	if bytes.HasPrefix(v, []byte{0x1f, 0x8b}) {
+		if r, err := gzip.NewReader(bytes.NewReader(v)); err == nil {
+			if b, err := io.ReadAll(r); err == nil {
+				v = b
+			}
+		}
+	}
+
+	// Leave binary content untouched.
+	if !utf8.Valid(v) {
+		return v
+	}
+
+	lines := strings.Split(strings.ReplaceAll(string(v), "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	text := strings.TrimRight(strings.Join(lines, "\n"), "\n")
+
+	if m := regexache.MustCompile(`(?i)content-type:\s*multipart/[^\n]*boundary="?([^";\s]+)"?`).FindStringSubmatch(text); m != nil {
+		text = strings.ReplaceAll(text, m[1], userDataMIMEBoundary)
+	}
+
+	return []byte(text)
+}
+
 func getInstanceVolumeIDs(ctx context.Context, conn *ec2.EC2, instanceId string) ([]string, error) {
 	volumeIds := []string{}
 
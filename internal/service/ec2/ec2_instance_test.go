@@ -4,8 +4,11 @@
 package ec2_test
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/sha1"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"reflect"
@@ -546,6 +549,48 @@ func TestAccEC2Instance_userDataBase64(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_userData_upgradeNormalizedHash(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// A trailing newline, as produced by heredocs, is removed by normalization.
+	userData := "#!/bin/bash\necho hello world\n"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		CheckDestroy: testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "5.14.0",
+					},
+				},
+				Config: testAccInstanceConfig_userData(rName, userData),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccInstanceConfig_userData(rName, userData),
+				PlanOnly:                 true,
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccInstanceConfig_userData(rName, userData),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_userDataBase64_updateWithBashFile(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Instance
@@ -5485,6 +5530,85 @@ func testAccCheckDetachVolumes(ctx context.Context, instance *ec2.Instance) reso
 	}
 }
 
+func TestUserDataEquivalent(t *testing.T) {
+	t.Parallel()
+
+	gzipped := func(s string) string {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write([]byte(s)); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return base64.StdEncoding.EncodeToString(buf.Bytes())
+	}
+
+	const multiPart = `Content-Type: multipart/mixed; boundary="%[1]s"
+MIME-Version: 1.0
+
+--%[1]s
+Content-Type: text/x-shellscript
+
+#!/bin/bash
+echo hello
+--%[1]s--
+`
+
+	testCases := map[string]struct {
+		old, new string
+		want     bool
+	}{
+		"identical": {
+			old:  "#!/bin/bash\necho hello\n",
+			new:  "#!/bin/bash\necho hello\n",
+			want: true,
+		},
+		"base64 encoded": {
+			old:  "#!/bin/bash\necho hello\n",
+			new:  base64.StdEncoding.EncodeToString([]byte("#!/bin/bash\necho hello\n")),
+			want: true,
+		},
+		"gzip compressed": {
+			old:  base64.StdEncoding.EncodeToString([]byte("#!/bin/bash\necho hello\n")),
+			new:  gzipped("#!/bin/bash\necho hello\n"),
+			want: true,
+		},
+		"whitespace": {
+			old:  "#!/bin/bash\necho hello\n",
+			new:  "#!/bin/bash  \r\necho hello\t\r\n\n\n",
+			want: true,
+		},
+		"MIME boundary": {
+			old:  fmt.Sprintf(multiPart, "MIMEBOUNDARY"),
+			new:  fmt.Sprintf(multiPart, "==BOUNDARY_1234567890=="),
+			want: true,
+		},
+		"different content": {
+			old:  "#!/bin/bash\necho hello\n",
+			new:  "#!/bin/bash\necho world\n",
+			want: false,
+		},
+		"leading whitespace": {
+			old:  "#cloud-config\npackages:\n  - nginx\n",
+			new:  "#cloud-config\npackages:\n- nginx\n",
+			want: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfec2.UserDataEquivalent(testCase.old, testCase.new), testCase.want; got != want {
+				t.Errorf("UserDataEquivalent(%q, %q) = %t, want %t", testCase.old, testCase.new, got, want)
+			}
+		})
+	}
+}
+
 func TestInstanceHostIDSchema(t *testing.T) {
 	t.Parallel()
 
@@ -937,6 +937,9 @@ func ResourceLaunchTemplate() *schema.Resource {
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return userDataEquivalent(old, new)
+				},
 			},
 			"vpc_security_group_ids": {
 				Type:          schema.TypeSet,
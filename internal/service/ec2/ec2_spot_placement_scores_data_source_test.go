@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2SpotPlacementScoresDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "scores.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "scores.0.availability_zone_id", ""),
+					resource.TestMatchResourceAttr(dataSourceName, "scores.0.score", regexache.MustCompile(`^([1-9]|10)$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2SpotPlacementScoresDataSource_singleAvailabilityZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_spot_placement_scores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotPlacementScoresDataSourceConfig_singleAvailabilityZone(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "scores.0.availability_zone_id", regexache.MustCompile(`^[a-z]{3,4}\d-az\d+$`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scores.0.region", "data.aws_region.current", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpotPlacementScoresDataSourceConfig_basic() string {
+	return `
+data "aws_ec2_spot_placement_scores" "test" {
+  instance_types  = ["m5.large", "m5a.large", "m6i.large"]
+  target_capacity = 2
+}
+`
+}
+
+func testAccSpotPlacementScoresDataSourceConfig_singleAvailabilityZone() string {
+	return `
+data "aws_region" "current" {}
+
+data "aws_ec2_spot_placement_scores" "test" {
+  instance_types           = ["m5.large", "m5a.large", "m6i.large"]
+  region_names             = [data.aws_region.current.name]
+  single_availability_zone = true
+  target_capacity          = 2
+}
+`
+}
@@ -9,6 +9,8 @@ var (
 	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
 	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
 
-	UpdateTags   = updateTags
-	UpdateTagsV2 = updateTagsV2
+	ServiceManagedPrefixListName = serviceManagedPrefixListName
+	UpdateTags                   = updateTags
+	UpdateTagsV2                 = updateTagsV2
+	UserDataEquivalent           = userDataEquivalent
 )
@@ -1241,6 +1241,30 @@ func FindInstanceTypeOfferings(ctx context.Context, conn *ec2.EC2, input *ec2.De
 	return output, nil
 }
 
+func FindSpotPlacementScores(ctx context.Context, conn *ec2.EC2, input *ec2.GetSpotPlacementScoresInput) ([]*ec2.SpotPlacementScore, error) {
+	var output []*ec2.SpotPlacementScore
+
+	err := conn.GetSpotPlacementScoresPagesWithContext(ctx, input, func(page *ec2.GetSpotPlacementScoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SpotPlacementScores {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindPublicIPv4Pool(ctx context.Context, conn *ec2.EC2, input *ec2.DescribePublicIpv4PoolsInput) (*ec2.PublicIpv4Pool, error) {
 	output, err := FindPublicIPv4Pools(ctx, conn, input)
 
@@ -6592,6 +6616,30 @@ func FindPrefixListByName(ctx context.Context, conn *ec2.EC2, name string) (*ec2
 	return FindPrefixList(ctx, conn, input)
 }
 
+func FindVPCEndpointConnections(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeVpcEndpointConnectionsInput) ([]*ec2.VpcEndpointConnection, error) {
+	var output []*ec2.VpcEndpointConnection
+
+	err := conn.DescribeVpcEndpointConnectionsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcEndpointConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VpcEndpointConnections {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindVPCEndpointConnectionByServiceIDAndVPCEndpointID(ctx context.Context, conn *ec2.EC2, serviceID, vpcEndpointID string) (*ec2.VpcEndpointConnection, error) {
 	input := &ec2.DescribeVpcEndpointConnectionsInput{
 		Filters: BuildAttributeFilterList(map[string]string{
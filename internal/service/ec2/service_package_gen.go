@@ -188,10 +188,22 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSerialConsoleAccess,
 			TypeName: "aws_ec2_serial_console_access",
 		},
+		{
+			Factory:  DataSourceServiceManagedPrefixList,
+			TypeName: "aws_ec2_service_managed_prefix_list",
+		},
+		{
+			Factory:  DataSourceSpotPlacementScores,
+			TypeName: "aws_ec2_spot_placement_scores",
+		},
 		{
 			Factory:  DataSourceSpotPrice,
 			TypeName: "aws_ec2_spot_price",
 		},
+		{
+			Factory:  DataSourceTrafficMirrorFilterRules,
+			TypeName: "aws_ec2_traffic_mirror_filter_rules",
+		},
 		{
 			Factory:  DataSourceTransitGateway,
 			TypeName: "aws_ec2_transit_gateway",
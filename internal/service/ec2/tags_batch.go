@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+const (
+	// tagsBatchMaxSize is the maximum number of values in a DescribeTags filter.
+	tagsBatchMaxSize = 200
+	// tagsBatchTimeout bounds each batched request, which isn't tied to any caller's context.
+	tagsBatchTimeout = 5 * time.Minute
+)
+
+// ListTags lists ec2 service tags and set them in Context.
+// It is called from outside this package.
+// Calls made while a tag listing request is in flight, such as those made concurrently during refresh,
+// are batched into a single DescribeTags request.
+func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
+	client := meta.(*conns.AWSClient)
+	conn := client.EC2Conn(ctx)
+
+	v, _ := tagsBatchers.LoadOrStore(client, newTagsBatcher(func(ctx context.Context, ids []string) (map[string]tftags.KeyValueTags, error) {
+		return listResourcesTags(ctx, conn, ids)
+	}))
+
+	tags, err := v.(*tagsBatcher).listTags(ctx, identifier)
+
+	if err != nil {
+		return err
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = types.Some(tags)
+	}
+
+	return nil
+}
+
+// tagsBatchers holds a *tagsBatcher per *conns.AWSClient, so that provider configurations
+// with different credentials or endpoints never share a batch.
+var tagsBatchers sync.Map
+
+type tagsBatchResult struct {
+	tags tftags.KeyValueTags
+	err  error
+}
+
+// tagsBatcher coalesces concurrent tag listing requests.
+// The first request is sent immediately; requests made while it is in flight are sent together once it completes.
+type tagsBatcher struct {
+	list func(context.Context, []string) (map[string]tftags.KeyValueTags, error)
+
+	mu       sync.Mutex
+	pending  map[string][]chan tagsBatchResult
+	inFlight bool
+}
+
+func newTagsBatcher(list func(context.Context, []string) (map[string]tftags.KeyValueTags, error)) *tagsBatcher {
+	return &tagsBatcher{
+		list:    list,
+		pending: make(map[string][]chan tagsBatchResult),
+	}
+}
+
+func (b *tagsBatcher) listTags(ctx context.Context, identifier string) (tftags.KeyValueTags, error) {
+	ch := make(chan tagsBatchResult, 1)
+
+	b.mu.Lock()
+	b.pending[identifier] = append(b.pending[identifier], ch)
+	if !b.inFlight {
+		b.inFlight = true
+		go b.run()
+	}
+	b.mu.Unlock()
+
+	// A cancelled caller stops waiting, but the batch continues for the other callers.
+	select {
+	case <-ctx.Done():
+		return tftags.New(ctx, nil), ctx.Err()
+	case result := <-ch:
+		return result.tags, result.err
+	}
+}
+
+// run sends batches until no requests are pending.
+func (b *tagsBatcher) run() {
+	for {
+		b.mu.Lock()
+		if len(b.pending) == 0 {
+			b.inFlight = false
+			b.mu.Unlock()
+
+			return
+		}
+
+		batch := make(map[string][]chan tagsBatchResult)
+		for id, chs := range b.pending {
+			if len(batch) == tagsBatchMaxSize {
+				break
+			}
+
+			batch[id] = chs
+			delete(b.pending, id)
+		}
+		b.mu.Unlock()
+
+		b.send(batch)
+	}
+}
+
+func (b *tagsBatcher) send(batch map[string][]chan tagsBatchResult) {
+	ctx, cancel := context.WithTimeout(context.Background(), tagsBatchTimeout)
+	defer cancel()
+
+	ids := make([]string, 0, len(batch))
+	for id := range batch {
+		ids = append(ids, id)
+	}
+
+	tags, err := b.list(ctx, ids)
+
+	for id, chs := range batch {
+		result := tagsBatchResult{err: err}
+		if err == nil {
+			result.tags = tags[id]
+			if result.tags == nil {
+				result.tags = tftags.New(ctx, nil)
+			}
+		}
+
+		// Each channel is buffered, so callers that have stopped waiting don't block delivery.
+		for _, ch := range chs {
+			ch <- result
+		}
+	}
+}
+
+// listResourcesTags lists ec2 service tags for multiple resources.
+func listResourcesTags(ctx context.Context, conn ec2iface.EC2API, ids []string) (map[string]tftags.KeyValueTags, error) {
+	if len(ids) == 1 {
+		tags, err := listResourceTags(ctx, conn, ids[0])
+
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]tftags.KeyValueTags{ids[0]: tags}, nil
+	}
+
+	input := &ec2.DescribeTagsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("resource-id"),
+				Values: aws.StringSlice(ids),
+			},
+		},
+	}
+	tagDescriptions := make(map[string][]*ec2.TagDescription)
+
+	err := conn.DescribeTagsPagesWithContext(ctx, input, func(page *ec2.DescribeTagsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Tags {
+			if v != nil {
+				id := aws.StringValue(v.ResourceId)
+				tagDescriptions[id] = append(tagDescriptions[id], v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]tftags.KeyValueTags, len(tagDescriptions))
+	for id, v := range tagDescriptions {
+		output[id] = KeyValueTags(ctx, v)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// pendingCount returns the number of requests waiting to be sent.
+func (b *tagsBatcher) pendingCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := 0
+	for _, chs := range b.pending {
+		n += len(chs)
+	}
+
+	return n
+}
+
+// waitForPending waits until n requests are waiting to be sent.
+func waitForPending(t *testing.T, b *tagsBatcher, n int) {
+	t.Helper()
+
+	for deadline := time.Now().Add(10 * time.Second); b.pendingCount() != n; {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %d pending requests, have %d", n, b.pendingCount())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestTagsBatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var calls [][]string
+	started, release := make(chan struct{}), make(chan struct{})
+	b := newTagsBatcher(func(ctx context.Context, ids []string) (map[string]tftags.KeyValueTags, error) {
+		mu.Lock()
+		sorted := append([]string(nil), ids...)
+		sort.Strings(sorted)
+		calls = append(calls, sorted)
+		first := len(calls) == 1
+		mu.Unlock()
+
+		// Hold the first request in flight until all the others have been queued.
+		if first {
+			close(started)
+			<-release
+		}
+
+		output := make(map[string]tftags.KeyValueTags)
+		for _, id := range ids {
+			if id != "i-untagged" {
+				output[id] = tftags.New(ctx, map[string]string{"Name": id})
+			}
+		}
+
+		return output, nil
+	})
+
+	ids := []string{"i-1", "i-2", "i-3", "i-2", "i-untagged"}
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	listTags := func(i int, id string) {
+		defer wg.Done()
+
+		tags, err := b.listTags(ctx, id)
+
+		if err != nil {
+			errs[i] = err
+			return
+		}
+
+		want := map[string]string{"Name": id}
+		if id == "i-untagged" {
+			want = map[string]string{}
+		}
+
+		if got := tags.Map(); fmt.Sprint(got) != fmt.Sprint(want) {
+			errs[i] = fmt.Errorf("%s: got %v, want %v", id, got, want)
+		}
+	}
+
+	wg.Add(1)
+	go listTags(0, ids[0])
+	<-started
+
+	for i, id := range ids[1:] {
+		wg.Add(1)
+		go listTags(i+1, id)
+	}
+	waitForPending(t, b, len(ids)-1)
+	close(release)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+
+	if got, want := fmt.Sprint(calls), fmt.Sprint([][]string{{"i-1"}, {"i-2", "i-3", "i-untagged"}}); got != want {
+		t.Errorf("got list calls %s, want %s", got, want)
+	}
+}
+
+func TestTagsBatcher_error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	want := errors.New("test error")
+	b := newTagsBatcher(func(ctx context.Context, ids []string) (map[string]tftags.KeyValueTags, error) {
+		return nil, want
+	})
+
+	if _, err := b.listTags(ctx, "i-1"); !errors.Is(err, want) {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
+
+func TestTagsBatcher_callerCancelled(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	b := newTagsBatcher(func(ctx context.Context, ids []string) (map[string]tftags.KeyValueTags, error) {
+		<-release
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		output := make(map[string]tftags.KeyValueTags)
+		for _, id := range ids {
+			output[id] = tftags.New(ctx, map[string]string{"Name": id})
+		}
+
+		return output, nil
+	})
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan error)
+	go func() {
+		_, err := b.listTags(cancelledCtx, "i-1")
+		cancelled <- err
+	}()
+	other := make(chan error)
+	go func() {
+		_, err := b.listTags(context.Background(), "i-2")
+		other <- err
+	}()
+
+	// Cancel one caller while the request is in flight.
+	cancel()
+	cancelledErr := <-cancelled
+	close(release)
+	otherErr := <-other
+
+	if !errors.Is(cancelledErr, context.Canceled) {
+		t.Errorf("cancelled caller: got error %v, want %v", cancelledErr, context.Canceled)
+	}
+	if otherErr != nil {
+		t.Errorf("other caller: got error %v", otherErr)
+	}
+}
@@ -19,7 +19,7 @@ import (
 
 // GetTag fetches an individual ec2 service tag for a resource.
 // Returns whether the key value and any errors. A NotFoundError is used to signal that no value was found.
-// This function will optimise the handling over listTags, if possible.
+// This function will optimise the handling over listResourceTags, if possible.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
 func GetTag(ctx context.Context, conn ec2iface.EC2API, identifier, key string) (*string, error) {
@@ -51,10 +51,10 @@ func GetTag(ctx context.Context, conn ec2iface.EC2API, identifier, key string) (
 	return listTags.KeyValue(key), nil
 }
 
-// listTags lists ec2 service tags.
+// listResourceTags lists ec2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func listTags(ctx context.Context, conn ec2iface.EC2API, identifier string) (tftags.KeyValueTags, error) {
+func listResourceTags(ctx context.Context, conn ec2iface.EC2API, identifier string) (tftags.KeyValueTags, error) {
 	input := &ec2.DescribeTagsInput{
 		Filters: []*ec2.Filter{
 			{
@@ -73,22 +73,6 @@ func listTags(ctx context.Context, conn ec2iface.EC2API, identifier string) (tft
 	return KeyValueTags(ctx, output.Tags), nil
 }
 
-// ListTags lists ec2 service tags and set them in Context.
-// It is called from outside this package.
-func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
-	tags, err := listTags(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), identifier)
-
-	if err != nil {
-		return err
-	}
-
-	if inContext, ok := tftags.FromContext(ctx); ok {
-		inContext.TagsOut = types.Some(tags)
-	}
-
-	return nil
-}
-
 // []*SERVICE.Tag handling
 
 // Tags returns ec2 service tags.
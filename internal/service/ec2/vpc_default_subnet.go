@@ -5,7 +5,9 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -27,8 +29,8 @@ func ResourceDefaultSubnet() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDefaultSubnetCreate,
-		ReadWithoutTimeout:   resourceSubnetRead,
-		UpdateWithoutTimeout: resourceSubnetUpdate,
+		ReadWithoutTimeout:   resourceDefaultSubnetRead,
+		UpdateWithoutTimeout: resourceDefaultSubnetUpdate,
 		DeleteWithoutTimeout: resourceDefaultSubnetDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -55,6 +57,7 @@ func ResourceDefaultSubnet() *schema.Resource {
 		//   - outpost_arn is Computed-only
 		//   - vpc_id is Computed-only
 		// and additions:
+		//   - ensure_absent Optional/ForceNew
 		//   - existing_default_subnet Computed-only, set in resourceDefaultSubnetCreate
 		//   - force_destroy Optional
 		Schema: map[string]*schema.Schema{
@@ -104,6 +107,12 @@ func ResourceDefaultSubnet() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"ensure_absent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 			"existing_default_subnet": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -168,17 +177,13 @@ func resourceDefaultSubnetCreate(ctx context.Context, d *schema.ResourceData, me
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
 	availabilityZone := d.Get("availability_zone").(string)
-	input := &ec2.DescribeSubnetsInput{
-		Filters: BuildAttributeFilterList(
-			map[string]string{
-				"availabilityZone": availabilityZone,
-				"defaultForAz":     "true",
-			},
-		),
+
+	if d.Get("ensure_absent").(bool) {
+		return append(diags, resourceDefaultSubnetCreateAbsent(ctx, d, meta)...)
 	}
 
 	var computedIPv6CIDRBlock bool
-	subnet, err := FindSubnet(ctx, conn, input)
+	subnet, err := findDefaultSubnet(ctx, conn, availabilityZone)
 
 	if err == nil {
 		log.Printf("[INFO] Found existing EC2 Default Subnet (%s)", availabilityZone)
@@ -253,8 +258,82 @@ func resourceDefaultSubnetCreate(ctx context.Context, d *schema.ResourceData, me
 	return append(diags, resourceSubnetRead(ctx, d, meta)...)
 }
 
+// resourceDefaultSubnetCreateAbsent deletes the default subnet in the configured Availability Zone, if any.
+func resourceDefaultSubnetCreateAbsent(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	availabilityZone := d.Get("availability_zone").(string)
+	subnet, err := findDefaultSubnet(ctx, conn, availabilityZone)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("existing_default_subnet", false)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Default Subnet (%s): %s", availabilityZone, err)
+	default:
+		d.Set("existing_default_subnet", true)
+
+		if err := checkDefaultNetworkUnused(ctx, conn, "subnet-id", aws.StringValue(subnet.SubnetId)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Default Subnet (%s): %s", aws.StringValue(subnet.SubnetId), err)
+		}
+
+		log.Printf("[INFO] Deleting EC2 Default Subnet: %s", aws.StringValue(subnet.SubnetId))
+		if err := deleteSubnet(ctx, conn, aws.StringValue(subnet.SubnetId), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	// There's no subnet to identify the resource by.
+	d.SetId(availabilityZone)
+
+	return diags
+}
+
+func resourceDefaultSubnetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !d.Get("ensure_absent").(bool) {
+		return append(diags, resourceSubnetRead(ctx, d, meta)...)
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	availabilityZone := d.Get("availability_zone").(string)
+	subnet, err := findDefaultSubnet(ctx, conn, availabilityZone)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Default Subnet (%s): %s", availabilityZone, err)
+	}
+
+	// A default subnet has been (re)created, so "create" this resource again to delete it.
+	log.Printf("[WARN] EC2 Default Subnet (%s) exists, removing from state", aws.StringValue(subnet.SubnetId))
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDefaultSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Get("ensure_absent").(bool) {
+		return diags
+	}
+
+	return append(diags, resourceSubnetUpdate(ctx, d, meta)...)
+}
+
 func resourceDefaultSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	if d.Get("ensure_absent").(bool) {
+		log.Printf("[DEBUG] EC2 Default Subnet absent (%s), removing from state", d.Id())
+		return diags
+	}
+
 	if d.Get("force_destroy").(bool) {
 		return append(diags, resourceSubnetDelete(ctx, d, meta)...)
 	}
@@ -263,3 +342,94 @@ func resourceDefaultSubnetDelete(ctx context.Context, d *schema.ResourceData, me
 
 	return diags
 }
+
+func findDefaultSubnet(ctx context.Context, conn *ec2.EC2, availabilityZone string) (*ec2.Subnet, error) {
+	input := &ec2.DescribeSubnetsInput{
+		Filters: BuildAttributeFilterList(
+			map[string]string{
+				"availabilityZone": availabilityZone,
+				"defaultForAz":     "true",
+			},
+		),
+	}
+
+	return FindSubnet(ctx, conn, input)
+}
+
+// checkDefaultNetworkUnused returns an error listing any resources in the default VPC or subnet
+// identified by the specified filter (`vpc-id` or `subnet-id`) that would be lost by deleting it.
+// Only default subnets and the default Internet gateway may be deleted along with a default VPC.
+func checkDefaultNetworkUnused(ctx context.Context, conn *ec2.EC2, filterName, id string) error {
+	var inUse []string
+
+	if filterName == "vpc-id" {
+		subnets, err := FindSubnets(ctx, conn, &ec2.DescribeSubnetsInput{
+			Filters: BuildAttributeFilterList(map[string]string{
+				"default-for-az": "false",
+				"vpc-id":         id,
+			}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading EC2 Subnets: %w", err)
+		}
+
+		for _, v := range subnets {
+			inUse = append(inUse, aws.StringValue(v.SubnetId))
+		}
+
+		output, err := conn.DescribeVpnGatewaysWithContext(ctx, &ec2.DescribeVpnGatewaysInput{
+			Filters: BuildAttributeFilterList(map[string]string{
+				"attachment.state":  ec2.AttachmentStatusAttached,
+				"attachment.vpc-id": id,
+			}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading EC2 VPN Gateways: %w", err)
+		}
+
+		for _, v := range output.VpnGateways {
+			inUse = append(inUse, aws.StringValue(v.VpnGatewayId))
+		}
+
+		attachments, err := FindTransitGatewayVPCAttachments(ctx, conn, &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+			Filters: BuildAttributeFilterList(map[string]string{
+				"vpc-id": id,
+			}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("reading EC2 Transit Gateway VPC Attachments: %w", err)
+		}
+
+		for _, v := range attachments {
+			switch aws.StringValue(v.State) {
+			case ec2.TransitGatewayAttachmentStateDeleted, ec2.TransitGatewayAttachmentStateDeleting, ec2.TransitGatewayAttachmentStateFailed, ec2.TransitGatewayAttachmentStateRejected:
+				continue
+			}
+
+			inUse = append(inUse, aws.StringValue(v.TransitGatewayAttachmentId))
+		}
+	}
+
+	networkInterfaces, err := FindNetworkInterfaces(ctx, conn, &ec2.DescribeNetworkInterfacesInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			filterName: id,
+		}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Network Interfaces: %w", err)
+	}
+
+	for _, v := range networkInterfaces {
+		inUse = append(inUse, aws.StringValue(v.NetworkInterfaceId))
+	}
+
+	if len(inUse) > 0 {
+		return fmt.Errorf("in use by %s; remove them before setting ensure_absent", strings.Join(inUse, ", "))
+	}
+
+	return nil
+}
@@ -150,6 +150,32 @@ func testAccDefaultSubnet_Existing_forceDestroy(t *testing.T) {
 	})
 }
 
+func testAccDefaultSubnet_Existing_ensureAbsent(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_default_subnet.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegionNot(t, endpoints.UsWest2RegionID, endpoints.UsGovWest1RegionID)
+			testAccPreCheckDefaultSubnetExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultSubnetDestroyNotFound(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCDefaultSubnetConfig_ensureAbsent(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultSubnetAbsent(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ensure_absent", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "availability_zone"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDefaultSubnet_Existing_ipv6(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Subnet
@@ -388,6 +414,38 @@ func testAccCheckDefaultSubnetDestroyNotFound(ctx context.Context) resource.Test
 	}
 }
 
+func testAccCheckDefaultSubnetAbsent(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		input := &ec2.DescribeSubnetsInput{
+			Filters: tfec2.BuildAttributeFilterList(
+				map[string]string{
+					"availabilityZone": rs.Primary.Attributes["availability_zone"],
+					"defaultForAz":     "true",
+				},
+			),
+		}
+
+		subnet, err := tfec2.FindSubnet(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Default Subnet %s still exists", aws.StringValue(subnet.SubnetId))
+	}
+}
+
 func testAccCreateMissingDefaultSubnets(ctx context.Context) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
 
@@ -461,6 +519,15 @@ resource "aws_default_subnet" "test" {
 `)
 }
 
+func testAccVPCDefaultSubnetConfig_ensureAbsent() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), `
+resource "aws_default_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  ensure_absent     = true
+}
+`)
+}
+
 func testAccVPCDefaultSubnetConfig_ipv6() string {
 	return acctest.ConfigCompose(testAccDefaultSubnetConfigBaseExisting, `
 resource "aws_default_vpc" "test" {
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,8 +28,8 @@ func ResourceDefaultVPC() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDefaultVPCCreate,
-		ReadWithoutTimeout:   resourceVPCRead,
-		UpdateWithoutTimeout: resourceVPCUpdate,
+		ReadWithoutTimeout:   resourceDefaultVPCRead,
+		UpdateWithoutTimeout: resourceDefaultVPCUpdate,
 		DeleteWithoutTimeout: resourceDefaultVPCDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -47,6 +48,7 @@ func ResourceDefaultVPC() *schema.Resource {
 		//   - ipv4_ipam_pool_id is omitted as it's not set in resourceVPCRead
 		//   - ipv4_netmask_length is omitted as it's not set in resourceVPCRead
 		// and additions:
+		//   - ensure_absent Optional/ForceNew
 		//   - existing_default_vpc Computed-only, set in resourceDefaultVPCCreate
 		//   - force_destroy Optional
 		Schema: map[string]*schema.Schema{
@@ -94,6 +96,12 @@ func ResourceDefaultVPC() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"ensure_absent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 			"existing_default_vpc": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -157,16 +165,12 @@ func resourceDefaultVPCCreate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	input := &ec2.DescribeVpcsInput{
-		Filters: buildAttributeFilterListV2(
-			map[string]string{
-				"isDefault": "true",
-			},
-		),
+	if d.Get("ensure_absent").(bool) {
+		return append(diags, resourceDefaultVPCCreateAbsent(ctx, d, meta)...)
 	}
 
 	vpcInfo := &vpcInfo{}
-	vpc, err := findVPCV2(ctx, conn, input)
+	vpc, err := findDefaultVPCV2(ctx, conn)
 
 	if err == nil {
 		d.SetId(aws.ToString(vpc.VpcId))
@@ -283,8 +287,107 @@ func resourceDefaultVPCCreate(ctx context.Context, d *schema.ResourceData, meta
 	return append(diags, resourceVPCRead(ctx, d, meta)...)
 }
 
+// resourceDefaultVPCCreateAbsent deletes the default VPC, if any, along with its default subnets and Internet gateway.
+func resourceDefaultVPCCreateAbsent(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	vpc, err := findDefaultVPCV2(ctx, conn)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("existing_default_vpc", false)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Default VPC: %s", err)
+	default:
+		vpcID := aws.ToString(vpc.VpcId)
+		d.Set("existing_default_vpc", true)
+
+		// The VPC can't be deleted until its default subnets and Internet gateway are gone.
+		// Refuse to delete anything else that's been created in the VPC.
+		connV1 := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+		if err := checkDefaultNetworkUnused(ctx, connV1, "vpc-id", vpcID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Default VPC (%s): %s", vpcID, err)
+		}
+
+		if err := deleteDefaultSubnets(ctx, connV1, vpcID, vpcDeletedTimeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Default VPC (%s): %s", vpcID, err)
+		}
+
+		if err := deleteVPCInternetGateways(ctx, connV1, vpcID, vpcDeletedTimeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Default VPC (%s): %s", vpcID, err)
+		}
+
+		log.Printf("[INFO] Deleting EC2 Default VPC: %s", vpcID)
+		_, err := tfresource.RetryWhenAWSErrCodeEqualsV2(ctx, vpcDeletedTimeout, func() (interface{}, error) {
+			return conn.DeleteVpc(ctx, &ec2.DeleteVpcInput{
+				VpcId: aws.String(vpcID),
+			})
+		}, errCodeDependencyViolation)
+
+		if err != nil && !tfawserr_sdkv2.ErrCodeEquals(err, errCodeInvalidVPCIDNotFound) {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Default VPC (%s): %s", vpcID, err)
+		}
+
+		_, err = tfresource.RetryUntilNotFound(ctx, vpcDeletedTimeout, func() (interface{}, error) {
+			return findVPCByIDV2(ctx, conn, vpcID)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Default VPC (%s) delete: %s", vpcID, err)
+		}
+	}
+
+	// There's no VPC to identify the resource by.
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return diags
+}
+
+func resourceDefaultVPCRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !d.Get("ensure_absent").(bool) {
+		return append(diags, resourceVPCRead(ctx, d, meta)...)
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	vpc, err := findDefaultVPCV2(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Default VPC: %s", err)
+	}
+
+	// A default VPC has been (re)created, so "create" this resource again to delete it.
+	log.Printf("[WARN] EC2 Default VPC (%s) exists, removing from state", aws.ToString(vpc.VpcId))
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDefaultVPCUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.Get("ensure_absent").(bool) {
+		return diags
+	}
+
+	return append(diags, resourceVPCUpdate(ctx, d, meta)...)
+}
+
 func resourceDefaultVPCDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	if d.Get("ensure_absent").(bool) {
+		log.Printf("[DEBUG] EC2 Default VPC absent (%s), removing from state", d.Id())
+		return diags
+	}
+
 	if d.Get("force_destroy").(bool) {
 		return append(diags, resourceVPCDelete(ctx, d, meta)...)
 	}
@@ -293,3 +396,15 @@ func resourceDefaultVPCDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
+
+func findDefaultVPCV2(ctx context.Context, conn *ec2.Client) (*awstypes.Vpc, error) {
+	input := &ec2.DescribeVpcsInput{
+		Filters: buildAttributeFilterListV2(
+			map[string]string{
+				"isDefault": "true",
+			},
+		),
+	}
+
+	return findVPCV2(ctx, conn, input)
+}
@@ -29,6 +29,7 @@ func TestAccVPCDefaultVPCAndSubnet_serial(t *testing.T) {
 			"existing.basic":                                testAccDefaultVPC_Existing_basic,
 			"existing.assignGeneratedIPv6CIDRBlock":         testAccDefaultVPC_Existing_assignGeneratedIPv6CIDRBlock,
 			"existing.forceDestroy":                         testAccDefaultVPC_Existing_forceDestroy,
+			"existing.ensureAbsent":                         testAccDefaultVPC_Existing_ensureAbsent,
 			"notFound.basic":                                testAccDefaultVPC_NotFound_basic,
 			"notFound.assignGeneratedIPv6CIDRBlock":         testAccDefaultVPC_NotFound_assignGeneratedIPv6CIDRBlock,
 			"notFound.forceDestroy":                         testAccDefaultVPC_NotFound_forceDestroy,
@@ -37,6 +38,7 @@ func TestAccVPCDefaultVPCAndSubnet_serial(t *testing.T) {
 		"Subnet": {
 			"existing.basic":                         testAccDefaultSubnet_Existing_basic,
 			"existing.forceDestroy":                  testAccDefaultSubnet_Existing_forceDestroy,
+			"existing.ensureAbsent":                  testAccDefaultSubnet_Existing_ensureAbsent,
 			"existing.ipv6":                          testAccDefaultSubnet_Existing_ipv6,
 			"existing.privateDnsNameOptionsOnLaunch": testAccDefaultSubnet_Existing_privateDNSNameOptionsOnLaunch,
 			"notFound.basic":                         testAccDefaultSubnet_NotFound_basic,
@@ -197,6 +199,33 @@ func testAccDefaultVPC_Existing_forceDestroy(t *testing.T) {
 	})
 }
 
+func testAccDefaultVPC_Existing_ensureAbsent(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_default_vpc.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegionNot(t, endpoints.UsWest2RegionID, endpoints.UsGovWest1RegionID)
+			testAccPreCheckDefaultVPCExists(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDefaultVPCDestroyNotFound(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCDefaultVPCConfig_ensureAbsent,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDefaultVPCAbsent(ctx),
+					resource.TestCheckResourceAttr(resourceName, "ensure_absent", "true"),
+					resource.TestCheckResourceAttr(resourceName, "existing_default_vpc", "true"),
+					resource.TestCheckResourceAttr(resourceName, "id", acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
 func testAccDefaultVPC_NotFound_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Vpc
@@ -466,6 +495,32 @@ func testAccCheckDefaultVPCDestroyNotFound(ctx context.Context) resource.TestChe
 	}
 }
 
+func testAccCheckDefaultVPCAbsent(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		input := &ec2.DescribeVpcsInput{
+			Filters: tfec2.BuildAttributeFilterList(
+				map[string]string{
+					"isDefault": "true",
+				},
+			),
+		}
+
+		vpc, err := tfec2.FindVPC(ctx, conn, input)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Default VPC %s still exists", aws.StringValue(vpc.VpcId))
+	}
+}
+
 // testAccCheckDefaultVPCEmpty returns a TestCheckFunc that empties the specified default VPC.
 func testAccCheckDefaultVPCEmpty(ctx context.Context, v *ec2.Vpc) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -540,6 +595,12 @@ resource "aws_default_vpc" "test" {
 }
 `
 
+const testAccVPCDefaultVPCConfig_ensureAbsent = `
+resource "aws_default_vpc" "test" {
+  ensure_absent = true
+}
+`
+
 func testAccVPCDefaultVPCConfig_assignGeneratedIPv6CIDRBlock(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_default_vpc" "test" {
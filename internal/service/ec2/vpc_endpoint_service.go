@@ -7,13 +7,16 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"path"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -53,6 +56,14 @@ func ResourceVPCEndpointService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_accept_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9*?]{1,12}$`), "must be an AWS account ID, optionally containing * and ? wildcards"),
+				},
+			},
 			"availability_zones": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -85,6 +96,11 @@ func ResourceVPCEndpointService() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"pending_auto_accept_vpc_endpoint_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"private_dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -145,7 +161,10 @@ func ResourceVPCEndpointService() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceVPCEndpointServiceCustomizeDiff,
+		),
 	}
 }
 
@@ -198,6 +217,12 @@ func resourceVPCEndpointServiceCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if v, ok := d.GetOk("auto_accept_principals"); ok && v.(*schema.Set).Len() > 0 {
+		if err := vpcEndpointServiceAutoAcceptConnections(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set)), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "accepting EC2 VPC Endpoint Service (%s) connections: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceVPCEndpointServiceRead(ctx, d, meta)...)
 }
 
@@ -259,6 +284,18 @@ func resourceVPCEndpointServiceRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("allowed_principals", flattenAllowedPrincipals(allowedPrincipals))
 
+	if v, ok := d.GetOk("auto_accept_principals"); ok && v.(*schema.Set).Len() > 0 {
+		vpcEndpointIDs, err := findVPCEndpointServicePendingConnectionIDs(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set)))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Endpoint Service (%s) connections: %s", d.Id(), err)
+		}
+
+		d.Set("pending_auto_accept_vpc_endpoint_ids", vpcEndpointIDs)
+	} else {
+		d.Set("pending_auto_accept_vpc_endpoint_ids", nil)
+	}
+
 	return diags
 }
 
@@ -308,6 +345,12 @@ func resourceVPCEndpointServiceUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if v, ok := d.GetOk("auto_accept_principals"); ok && v.(*schema.Set).Len() > 0 {
+		if err := vpcEndpointServiceAutoAcceptConnections(ctx, conn, d.Id(), flex.ExpandStringValueSet(v.(*schema.Set)), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "accepting EC2 VPC Endpoint Service (%s) connections: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceVPCEndpointServiceRead(ctx, d, meta)...)
 }
 
@@ -339,6 +382,94 @@ func resourceVPCEndpointServiceDelete(ctx context.Context, d *schema.ResourceDat
 	return diags
 }
 
+func resourceVPCEndpointServiceCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Connections from matching principals that are awaiting acceptance force an update, during which they are accepted.
+	if diff.Id() != "" && diff.Get("pending_auto_accept_vpc_endpoint_ids").(*schema.Set).Len() > 0 {
+		if v, ok := diff.GetOk("auto_accept_principals"); ok && v.(*schema.Set).Len() > 0 {
+			return diff.SetNew("pending_auto_accept_vpc_endpoint_ids", []interface{}{})
+		}
+	}
+
+	return nil
+}
+
+// findVPCEndpointServicePendingConnectionIDs returns the IDs of the VPC endpoints awaiting acceptance
+// whose owner matches any of the specified account ID patterns.
+func findVPCEndpointServicePendingConnectionIDs(ctx context.Context, conn *ec2.EC2, serviceID string, patterns []string) ([]string, error) {
+	input := &ec2.DescribeVpcEndpointConnectionsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"service-id":         serviceID,
+			"vpc-endpoint-state": vpcEndpointStatePendingAcceptance,
+		}),
+	}
+
+	connections, err := FindVPCEndpointConnections(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var vpcEndpointIDs []string
+
+	for _, v := range connections {
+		if aws.StringValue(v.VpcEndpointState) != vpcEndpointStatePendingAcceptance {
+			continue
+		}
+
+		if vpcEndpointOwnerMatches(aws.StringValue(v.VpcEndpointOwner), patterns) {
+			vpcEndpointIDs = append(vpcEndpointIDs, aws.StringValue(v.VpcEndpointId))
+		}
+	}
+
+	return vpcEndpointIDs, nil
+}
+
+func vpcEndpointOwnerMatches(owner string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, err := path.Match(pattern, owner); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func vpcEndpointServiceAutoAcceptConnections(ctx context.Context, conn *ec2.EC2, serviceID string, patterns []string, timeout time.Duration) error {
+	vpcEndpointIDs, err := findVPCEndpointServicePendingConnectionIDs(ctx, conn, serviceID, patterns)
+
+	if err != nil {
+		return err
+	}
+
+	if len(vpcEndpointIDs) == 0 {
+		return nil
+	}
+
+	input := &ec2.AcceptVpcEndpointConnectionsInput{
+		ServiceId:      aws.String(serviceID),
+		VpcEndpointIds: aws.StringSlice(vpcEndpointIDs),
+	}
+
+	log.Printf("[DEBUG] Accepting VPC Endpoint Connections: %s", input)
+	output, err := conn.AcceptVpcEndpointConnectionsWithContext(ctx, input)
+
+	if err == nil && output != nil {
+		err = UnsuccessfulItemsError(output.Unsuccessful)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, vpcEndpointID := range vpcEndpointIDs {
+		if _, err := waitVPCEndpointConnectionAccepted(ctx, conn, serviceID, vpcEndpointID, timeout); err != nil {
+			return fmt.Errorf("waiting for VPC Endpoint Connection (%s) to be accepted: %w", vpcEndpointID, err)
+		}
+	}
+
+	return nil
+}
+
 func flattenAllowedPrincipal(apiObject *ec2.AllowedPrincipal) *string {
 	if apiObject == nil {
 		return nil
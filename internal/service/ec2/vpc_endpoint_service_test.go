@@ -246,6 +246,46 @@ func TestAccVPCEndpointService_allowedPrincipals(t *testing.T) {
 	})
 }
 
+func TestAccVPCEndpointService_autoAcceptPrincipals(t *testing.T) {
+	ctx := acctest.Context(t)
+	var svcCfg ec2.ServiceConfiguration
+	resourceName := "aws_vpc_endpoint_service.test"
+	vpcEndpointResourceName := "aws_vpc_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix("tfacctest") // 32 character limit
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointServiceConfig_autoAcceptPrincipals(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointServiceExists(ctx, resourceName, &svcCfg),
+					resource.TestCheckResourceAttr(resourceName, "auto_accept_principals.#", "1"),
+					resource.TestCheckResourceAttr(vpcEndpointResourceName, "state", "pendingAcceptance"),
+				),
+				// The endpoint is created after the service so its connection is accepted on the next apply.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCEndpointServiceConfig_autoAcceptPrincipals(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCEndpointServiceExists(ctx, resourceName, &svcCfg),
+					resource.TestCheckResourceAttr(resourceName, "pending_auto_accept_vpc_endpoint_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccVPCEndpointServiceConfig_autoAcceptPrincipals(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(vpcEndpointResourceName, "state", "available"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCEndpointService_gatewayLoadBalancerARNs(t *testing.T) {
 	ctx := acctest.Context(t)
 	var svcCfg ec2.ServiceConfiguration
@@ -532,6 +572,34 @@ resource "aws_vpc_endpoint_service" "test" {
 `, rName, count))
 }
 
+func testAccVPCEndpointServiceConfig_autoAcceptPrincipals(rName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_baseNetworkLoadBalancer(rName, 1), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpc_endpoint_service" "test" {
+  acceptance_required        = true
+  network_load_balancer_arns = aws_lb.test[*].arn
+
+  auto_accept_principals = [data.aws_caller_identity.current.account_id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_endpoint" "test" {
+  vpc_id            = aws_vpc.test.id
+  service_name      = aws_vpc_endpoint_service.test.service_name
+  subnet_ids        = aws_subnet.test[*].id
+  vpc_endpoint_type = "Interface"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccVPCEndpointServiceConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_baseNetworkLoadBalancer(rName, 1), fmt.Sprintf(`
 resource "aws_vpc_endpoint_service" "test" {
@@ -159,31 +159,63 @@ func resourceInternetGatewayDelete(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
+	if err := deleteInternetGateway(ctx, conn, d.Id(), d.Get("vpc_id").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Internet Gateway (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// deleteInternetGateway deletes the specified Internet gateway, first detaching it from the VPC if vpcID is not empty.
+func deleteInternetGateway(ctx context.Context, conn *ec2.EC2, internetGatewayID, vpcID string, timeout time.Duration) error {
 	// Detach if it is attached.
-	if v, ok := d.GetOk("vpc_id"); ok {
-		if err := detachInternetGateway(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutDelete)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "deleting EC2 Internet Gateway (%s): %s", d.Id(), err)
+	if vpcID != "" {
+		if err := detachInternetGateway(ctx, conn, internetGatewayID, vpcID, timeout); err != nil {
+			return err
 		}
 	}
 
 	input := &ec2.DeleteInternetGatewayInput{
-		InternetGatewayId: aws.String(d.Id()),
+		InternetGatewayId: aws.String(internetGatewayID),
 	}
 
-	log.Printf("[INFO] Deleting Internet Gateway: %s", d.Id())
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	log.Printf("[INFO] Deleting Internet Gateway: %s", internetGatewayID)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
 		return conn.DeleteInternetGatewayWithContext(ctx, input)
 	}, errCodeDependencyViolation)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidInternetGatewayIDNotFound) {
-		return diags
+		return nil
+	}
+
+	return err
+}
+
+// deleteVPCInternetGateways detaches and deletes all Internet gateways attached to the specified VPC.
+func deleteVPCInternetGateways(ctx context.Context, conn *ec2.EC2, vpcID string, timeout time.Duration) error {
+	input := &ec2.DescribeInternetGatewaysInput{
+		Filters: BuildAttributeFilterList(
+			map[string]string{
+				"attachment.vpc-id": vpcID,
+			},
+		),
 	}
 
+	internetGateways, err := FindInternetGateways(ctx, conn, input)
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting EC2 Internet Gateway (%s): %s", d.Id(), err)
+		return fmt.Errorf("reading EC2 Internet Gateways for VPC (%s): %w", vpcID, err)
 	}
 
-	return diags
+	for _, v := range internetGateways {
+		id := aws.StringValue(v.InternetGatewayId)
+
+		if err := deleteInternetGateway(ctx, conn, id, vpcID, timeout); err != nil {
+			return fmt.Errorf("deleting EC2 Internet Gateway (%s): %w", id, err)
+		}
+	}
+
+	return nil
 }
 
 func attachInternetGateway(ctx context.Context, conn *ec2.EC2, internetGatewayID, vpcID string, timeout time.Duration) error {
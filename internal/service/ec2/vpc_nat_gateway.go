@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -86,7 +87,6 @@ func ResourceNATGateway() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"secondary_private_ip_addresses"},
 			},
 			"secondary_private_ip_addresses": {
@@ -214,7 +214,56 @@ func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	switch d.Get("connectivity_type").(string) {
 	case ec2.ConnectivityTypePrivate:
-		if d.HasChanges("secondary_private_ip_addresses") {
+		if d.HasChange("secondary_private_ip_address_count") && d.GetRawConfig().GetAttr("secondary_private_ip_addresses").IsNull() {
+			oRaw, nRaw := d.GetChange("secondary_private_ip_address_count")
+			o, n := oRaw.(int), nRaw.(int)
+
+			if n > o {
+				input := &ec2.AssignPrivateNatGatewayAddressInput{
+					NatGatewayId:          aws.String(d.Id()),
+					PrivateIpAddressCount: aws.Int64(int64(n - o)),
+				}
+
+				output, err := conn.AssignPrivateNatGatewayAddressWithContext(ctx, input)
+
+				if err != nil {
+					return diag.Errorf("assigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+				}
+
+				for _, v := range output.NatGatewayAddresses {
+					privateIP := aws.StringValue(v.PrivateIp)
+
+					if _, err := WaitNATGatewayAddressAssigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return diag.Errorf("waiting for EC2 NAT Gateway (%s) private IP address (%s) assign: %s", d.Id(), privateIP, err)
+					}
+				}
+			} else if n < o {
+				// Unassign the required number of secondary private IP addresses from those currently assigned.
+				v, _ := d.GetChange("secondary_private_ip_addresses")
+				privateIPs := flex.ExpandStringValueSet(v.(*schema.Set))
+				sort.Strings(privateIPs)
+				if del := o - n; del < len(privateIPs) {
+					privateIPs = privateIPs[len(privateIPs)-del:]
+				}
+
+				input := &ec2.UnassignPrivateNatGatewayAddressInput{
+					NatGatewayId:       aws.String(d.Id()),
+					PrivateIpAddresses: aws.StringSlice(privateIPs),
+				}
+
+				_, err := conn.UnassignPrivateNatGatewayAddressWithContext(ctx, input)
+
+				if err != nil {
+					return diag.Errorf("unassigning EC2 NAT Gateway (%s) private IP addresses: %s", d.Id(), err)
+				}
+
+				for _, privateIP := range privateIPs {
+					if _, err := WaitNATGatewayAddressUnassigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						return diag.Errorf("waiting for EC2 NAT Gateway (%s) private IP address (%s) unassign: %s", d.Id(), privateIP, err)
+					}
+				}
+			}
+		} else if d.HasChanges("secondary_private_ip_addresses") {
 			oRaw, nRaw := d.GetChange("secondary_private_ip_addresses")
 			o, n := oRaw.(*schema.Set), nRaw.(*schema.Set)
 
@@ -363,6 +412,20 @@ func resourceNATGatewayCustomizeDiff(ctx context.Context, diff *schema.ResourceD
 			return fmt.Errorf(`secondary_allocation_ids is not supported with connectivity_type = "%s"`, connectivityType)
 		}
 
+		if diff.Id() != "" {
+			if diff.HasChange("secondary_private_ip_address_count") && diff.GetRawConfig().GetAttr("secondary_private_ip_addresses").IsNull() {
+				if err := diff.SetNewComputed("secondary_private_ip_addresses"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_addresses to computed: %s", err)
+				}
+			}
+
+			if diff.HasChange("secondary_private_ip_addresses") && diff.GetRawConfig().GetAttr("secondary_private_ip_address_count").IsNull() {
+				if err := diff.SetNewComputed("secondary_private_ip_address_count"); err != nil {
+					return fmt.Errorf("setting secondary_private_ip_address_count to computed: %s", err)
+				}
+			}
+		}
+
 	case ec2.ConnectivityTypePublic:
 		if v := diff.GetRawConfig().GetAttr("secondary_private_ip_address_count"); v.IsKnown() && !v.IsNull() {
 			return fmt.Errorf(`secondary_private_ip_address_count is not supported with connectivity_type = "%s"`, connectivityType)
@@ -277,6 +277,22 @@ func TestAccVPCNATGateway_secondaryPrivateIPAddressCount(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", "5"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", "5"),
+				),
+			},
+			{
+				Config: testAccVPCNATGatewayConfig_secondaryPrivateIPAddressCount(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNATGatewayExists(ctx, resourceName, &natGateway),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_address_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ip_addresses.#", "2"),
+				),
+			},
 		},
 	})
 }
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -55,6 +57,10 @@ func ResourceNetworkInsightsAnalysis() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"expected_path_found": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"explanations": networkInsightsAnalysisExplanationsSchema,
 			"filter_in_arns": {
 				Type:     schema.TypeSet,
@@ -90,6 +96,12 @@ func ResourceNetworkInsightsAnalysis() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"wait_for_completion": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -101,7 +113,17 @@ func ResourceNetworkInsightsAnalysis() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// The analysis result is only checked when waiting for completion.
+				if !diff.GetRawConfig().GetAttr("expected_path_found").IsNull() && diff.NewValueKnown("wait_for_completion") && !diff.Get("wait_for_completion").(bool) {
+					return fmt.Errorf("`expected_path_found` requires `wait_for_completion` to be true")
+				}
+
+				return nil
+			},
+		),
 	}
 }
 
@@ -1425,9 +1447,16 @@ func resourceNetworkInsightsAnalysisCreate(ctx context.Context, d *schema.Resour
 	d.SetId(aws.StringValue(output.NetworkInsightsAnalysis.NetworkInsightsAnalysisId))
 
 	if d.Get("wait_for_completion").(bool) {
-		if _, err := WaitNetworkInsightsAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		output, err := WaitNetworkInsightsAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
 			return diag.Errorf("waiting for EC2 Network Insights Analysis (%s) create: %s", d.Id(), err)
 		}
+
+		// The ID is already set, so a failed check taints the resource and the analysis is run again on the next apply.
+		if err := checkNetworkInsightsAnalysisPathFound(d, output); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceNetworkInsightsAnalysisRead(ctx, d, meta)
@@ -1475,7 +1504,23 @@ func resourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceNetworkInsightsAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Tags only.
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if d.HasChange("expected_path_found") && d.Get("wait_for_completion").(bool) {
+		output, err := WaitNetworkInsightsAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("waiting for EC2 Network Insights Analysis (%s) update: %s", d.Id(), err)
+		}
+
+		if err := checkNetworkInsightsAnalysisPathFound(d, output); err != nil {
+			// Keep the previous state, so that the change stays in the plan and is checked again on the next apply.
+			d.Partial(true)
+
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceNetworkInsightsAnalysisRead(ctx, d, meta)
 }
 
@@ -1498,6 +1543,25 @@ func resourceNetworkInsightsAnalysisDelete(ctx context.Context, d *schema.Resour
 	return nil
 }
 
+// checkNetworkInsightsAnalysisPathFound returns an error if the analysis result doesn't match the configured expected_path_found value.
+func checkNetworkInsightsAnalysisPathFound(d *schema.ResourceData, output *ec2.NetworkInsightsAnalysis) error {
+	v, ok := d.GetOkExists("expected_path_found")
+
+	if !ok {
+		return nil
+	}
+
+	if expected, found := v.(bool), aws.BoolValue(output.NetworkPathFound); expected != found {
+		if expected {
+			return fmt.Errorf("EC2 Network Insights Analysis (%s): expected a path to be found, but the destination is not reachable", d.Id())
+		}
+
+		return fmt.Errorf("EC2 Network Insights Analysis (%s): expected no path to be found, but the destination is reachable", d.Id())
+	}
+
+	return nil
+}
+
 func flattenAdditionalDetail(apiObject *ec2.AdditionalDetail) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -188,6 +188,102 @@ func TestAccVPCNetworkInsightsAnalysis_waitForCompletion(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInsightsAnalysis_expectedPathFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCNetworkInsightsAnalysisConfig_expectedPathFoundNoWait(rName),
+				ExpectError: regexache.MustCompile("`expected_path_found` requires `wait_for_completion` to be true"),
+			},
+			{
+				Config:      testAccVPCNetworkInsightsAnalysisConfig_expectedPathFound(rName, false),
+				ExpectError: regexache.MustCompile(`expected no path to be found`),
+			},
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_expectedPathFound(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "expected_path_found", "true"),
+					resource.TestCheckResourceAttr(resourceName, "path_found", "true"),
+				),
+			},
+			{
+				Config:      testAccVPCNetworkInsightsAnalysisConfig_expectedPathFound(rName, false),
+				ExpectError: regexache.MustCompile(`expected no path to be found`),
+			},
+			{
+				// The failed update must not be saved to state.
+				Config:             testAccVPCNetworkInsightsAnalysisConfig_expectedPathFound(rName, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkInsightsAnalysis_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 string
+	resourceName := "aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkInsightsAnalysisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
+					testAccCheckNetworkInsightsAnalysisIDSaved(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				Config: testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkInsightsAnalysisExists(ctx, resourceName),
+					testAccCheckNetworkInsightsAnalysisIDSaved(resourceName, &v2),
+					testAccCheckNetworkInsightsAnalysisRecreated(&v1, &v2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkInsightsAnalysisIDSaved(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*v = rs.Primary.ID
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkInsightsAnalysisRecreated(before, after *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if *before == *after {
+			return fmt.Errorf("EC2 Network Insights Analysis (%s) was not recreated", *before)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckNetworkInsightsAnalysisExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -319,3 +415,46 @@ resource "aws_ec2_network_insights_analysis" "test" {
 }
 `, rName, waitForCompletion))
 }
+
+func testAccVPCNetworkInsightsAnalysisConfig_expectedPathFound(rName string, expectedPathFound bool) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  expected_path_found      = %[2]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, expectedPathFound))
+}
+
+func testAccVPCNetworkInsightsAnalysisConfig_expectedPathFoundNoWait(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  expected_path_found      = true
+  wait_for_completion      = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCNetworkInsightsAnalysisConfig_triggers(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+
+  triggers = {
+    redeployment = %[2]q
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, trigger))
+}
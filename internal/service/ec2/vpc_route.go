@@ -25,12 +25,17 @@ const (
 	routeDestinationCIDRBlock     = "destination_cidr_block"
 	routeDestinationIPv6CIDRBlock = "destination_ipv6_cidr_block"
 	routeDestinationPrefixListID  = "destination_prefix_list_id"
+	routeDestinationNAT64         = "destination_nat64"
 )
 
+// routeNAT64CIDRBlock is the well-known prefix (RFC 6052) used for DNS64 synthesized IPv6 addresses.
+const routeNAT64CIDRBlock = "64:ff9b::/96"
+
 var routeValidDestinations = []string{
 	routeDestinationCIDRBlock,
 	routeDestinationIPv6CIDRBlock,
 	routeDestinationPrefixListID,
+	routeDestinationNAT64,
 }
 
 var routeValidTargets = []string{
@@ -95,6 +100,13 @@ func ResourceRoute() *schema.Resource {
 				ForceNew:     true,
 				ExactlyOneOf: routeValidDestinations,
 			},
+			routeDestinationNAT64: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: routeValidDestinations,
+				RequiredWith: []string{"nat_gateway_id"},
+			},
 
 			//
 			// Targets.
@@ -308,7 +320,10 @@ func resourceRouteRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("carrier_gateway_id", route.CarrierGatewayId)
 	d.Set("core_network_arn", route.CoreNetworkArn)
 	d.Set(routeDestinationCIDRBlock, route.DestinationCidrBlock)
-	d.Set(routeDestinationIPv6CIDRBlock, route.DestinationIpv6CidrBlock)
+	// The NAT64 destination is a shorthand for the well-known prefix; don't also populate the IPv6 destination.
+	if !d.Get(routeDestinationNAT64).(bool) {
+		d.Set(routeDestinationIPv6CIDRBlock, route.DestinationIpv6CidrBlock)
+	}
 	d.Set(routeDestinationPrefixListID, route.DestinationPrefixListId)
 	// VPC Endpoint ID is returned in Gateway ID field
 	if strings.HasPrefix(aws.StringValue(route.GatewayId), "vpce-") {
@@ -495,7 +510,12 @@ func resourceRouteImport(ctx context.Context, d *schema.ResourceData, meta inter
 }
 
 // routeDestinationAttribute returns the attribute key and value of the route's destination.
+// A NAT64 destination is reported as an IPv6 destination with the well-known prefix.
 func routeDestinationAttribute(d *schema.ResourceData) (string, string, error) {
+	if d.Get(routeDestinationNAT64).(bool) {
+		return routeDestinationIPv6CIDRBlock, routeNAT64CIDRBlock, nil
+	}
+
 	for _, key := range routeValidDestinations {
 		if v, ok := d.Get(key).(string); ok && v != "" {
 			return key, v, nil
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// serviceManagedPrefixLists maps the services supported by the aws_ec2_service_managed_prefix_list
+// data source to the scope of their AWS-managed prefix lists and whether an IPv6 variant is published.
+// Services such as App Runner and Elastic Beanstalk aren't listed because they don't publish a managed prefix list.
+var serviceManagedPrefixLists = map[string]struct {
+	global bool
+	ipv6   bool
+}{
+	"cloudfront.origin-facing": {global: true},
+	"dynamodb":                 {},
+	"ec2-instance-connect":     {ipv6: true},
+	"route53-healthchecks":     {ipv6: true},
+	"s3":                       {},
+	"vpc-lattice":              {ipv6: true},
+}
+
+// serviceManagedPrefixListServices returns the supported services in sorted order,
+// so that validation error messages are stable.
+func serviceManagedPrefixListServices() []string {
+	services := maps.Keys(serviceManagedPrefixLists)
+	slices.Sort(services)
+
+	return services
+}
+
+// serviceManagedPrefixListName returns the name of the AWS-managed prefix list
+// published for the specified service, address family and Region.
+func serviceManagedPrefixListName(service, addressFamily, region string) (string, error) {
+	v, ok := serviceManagedPrefixLists[service]
+
+	if !ok {
+		return "", fmt.Errorf("unsupported service: %s", service)
+	}
+
+	if addressFamily == managedPrefixListAddressFamilyIPv6 {
+		if !v.ipv6 {
+			return "", fmt.Errorf("service (%s) does not publish an IPv6 managed prefix list", service)
+		}
+
+		service = "ipv6." + service
+	}
+
+	if v.global {
+		region = "global"
+	}
+
+	return fmt.Sprintf("com.amazonaws.%s.%s", region, service), nil
+}
+
+// @SDKDataSource("aws_ec2_service_managed_prefix_list")
+func DataSourceServiceManagedPrefixList() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceManagedPrefixListRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      managedPrefixListAddressFamilyIPv4,
+				ValidateFunc: validation.StringInSlice(managedPrefixListAddressFamily_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_entries": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(serviceManagedPrefixListServices(), false),
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	name, err := serviceManagedPrefixListName(d.Get("service").(string), d.Get("address_family").(string), meta.(*conns.AWSClient).Region)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &ec2.DescribeManagedPrefixListsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"owner-id":         "AWS",
+			"prefix-list-name": name,
+		}),
+	}
+
+	pl, err := FindManagedPrefixList(ctx, conn, input)
+
+	if err != nil {
+		return diag.FromErr(tfresource.SingularDataSourceFindError(fmt.Sprintf("EC2 Managed Prefix List (%s)", name), err))
+	}
+
+	d.SetId(aws.StringValue(pl.PrefixListId))
+
+	prefixListEntries, err := FindManagedPrefixListEntriesByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("reading EC2 Managed Prefix List (%s) Entries: %s", d.Id(), err)
+	}
+
+	cidrBlocks := make([]string, 0, len(prefixListEntries))
+	for _, v := range prefixListEntries {
+		cidrBlocks = append(cidrBlocks, aws.StringValue(v.Cidr))
+	}
+	sort.Strings(cidrBlocks)
+
+	d.Set("address_family", pl.AddressFamily)
+	d.Set("arn", pl.PrefixListArn)
+	d.Set("cidr_blocks", cidrBlocks)
+	d.Set("max_entries", pl.MaxEntries)
+	d.Set("name", pl.PrefixListName)
+	d.Set("version", pl.Version)
+
+	return nil
+}
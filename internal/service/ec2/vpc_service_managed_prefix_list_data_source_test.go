@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestServiceManagedPrefixListName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		service       string
+		addressFamily string
+		expected      string
+		expectError   bool
+	}{
+		{
+			service:       "cloudfront.origin-facing",
+			addressFamily: "IPv4",
+			expected:      "com.amazonaws.global.cloudfront.origin-facing",
+		},
+		{
+			service:       "cloudfront.origin-facing",
+			addressFamily: "IPv6",
+			expectError:   true,
+		},
+		{
+			service:       "s3",
+			addressFamily: "IPv4",
+			expected:      "com.amazonaws.us-west-2.s3",
+		},
+		{
+			service:       "route53-healthchecks",
+			addressFamily: "IPv6",
+			expected:      "com.amazonaws.us-west-2.ipv6.route53-healthchecks",
+		},
+		{
+			service:       "apprunner",
+			addressFamily: "IPv4",
+			expectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%s/%s", testCase.service, testCase.addressFamily), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfec2.ServiceManagedPrefixListName(testCase.service, testCase.addressFamily, "us-west-2")
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccVPCServiceManagedPrefixListDataSource_cloudFront(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_service_managed_prefix_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCServiceManagedPrefixListDataSourceConfig_basic("cloudfront.origin-facing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "address_family", "IPv4"),
+					resource.TestMatchResourceAttr(dataSourceName, "arn", regexache.MustCompile(`:prefix-list/pl-[0-9a-z]+$`)),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "cidr_blocks.#", 0),
+					resource.TestMatchResourceAttr(dataSourceName, "id", regexache.MustCompile(`^pl-[0-9a-z]+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "com.amazonaws.global.cloudfront.origin-facing"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCServiceManagedPrefixListDataSource_s3(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_service_managed_prefix_list.test"
+	prefixListDataSourceName := "data.aws_ec2_managed_prefix_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckManagedPrefixList(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCServiceManagedPrefixListDataSourceConfig_s3,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", prefixListDataSourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cidr_blocks.#", prefixListDataSourceName, "entries.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", prefixListDataSourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_entries", prefixListDataSourceName, "max_entries"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", prefixListDataSourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", prefixListDataSourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCServiceManagedPrefixListDataSourceConfig_basic(service string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_service_managed_prefix_list" "test" {
+  service = %[1]q
+}
+`, service)
+}
+
+const testAccVPCServiceManagedPrefixListDataSourceConfig_s3 = `
+data "aws_region" "current" {}
+
+data "aws_ec2_service_managed_prefix_list" "test" {
+  service = "s3"
+}
+
+data "aws_ec2_managed_prefix_list" "test" {
+  name = "com.amazonaws.${data.aws_region.current.name}.s3"
+}
+`
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -36,7 +37,10 @@ func ResourceSubnet() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceSubnetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -366,25 +370,93 @@ func resourceSubnetDelete(ctx context.Context, d *schema.ResourceData, meta inte
 
 	log.Printf("[INFO] Deleting EC2 Subnet: %s", d.Id())
 
-	if err := deleteLingeringENIs(ctx, conn, "subnet-id", d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting ENIs for EC2 Subnet (%s): %s", d.Id(), err)
+	if err := deleteSubnet(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func deleteSubnet(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) error {
+	if err := deleteLingeringENIs(ctx, conn, "subnet-id", id, timeout); err != nil {
+		return fmt.Errorf("deleting ENIs for EC2 Subnet (%s): %w", id, err)
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
 		return conn.DeleteSubnetWithContext(ctx, &ec2.DeleteSubnetInput{
-			SubnetId: aws.String(d.Id()),
+			SubnetId: aws.String(id),
 		})
 	}, errCodeDependencyViolation)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidSubnetIDNotFound) {
-		return diags
+		return nil
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting EC2 Subnet (%s): %s", d.Id(), err)
+		return fmt.Errorf("deleting EC2 Subnet (%s): %w", id, err)
 	}
 
-	return diags
+	return nil
+}
+
+// deleteDefaultSubnets deletes the default subnets in the specified VPC.
+func deleteDefaultSubnets(ctx context.Context, conn *ec2.EC2, vpcID string, timeout time.Duration) error {
+	input := &ec2.DescribeSubnetsInput{
+		Filters: BuildAttributeFilterList(
+			map[string]string{
+				"default-for-az": "true",
+				"vpc-id":         vpcID,
+			},
+		),
+	}
+
+	subnets, err := FindSubnets(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Default Subnets for VPC (%s): %w", vpcID, err)
+	}
+
+	for _, v := range subnets {
+		id := aws.StringValue(v.SubnetId)
+
+		log.Printf("[INFO] Deleting EC2 Default Subnet: %s", id)
+		if err := deleteSubnet(ctx, conn, id, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceSubnetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.Get("ipv6_native").(bool) {
+		return nil
+	}
+
+	// IPv6-only subnets have no IPv4 CIDR block, so no IPv4 address can be mapped or used for DNS on launch.
+	if v := diff.Get("cidr_block").(string); v != "" {
+		return fmt.Errorf(`"cidr_block" cannot be set for an IPv6-only subnet`)
+	}
+
+	if diff.NewValueKnown("ipv6_cidr_block") && diff.Get("ipv6_cidr_block").(string) == "" {
+		return fmt.Errorf(`"ipv6_cidr_block" must be set for an IPv6-only subnet`)
+	}
+
+	if !diff.Get("assign_ipv6_address_on_creation").(bool) {
+		return fmt.Errorf(`"assign_ipv6_address_on_creation" must be true for an IPv6-only subnet`)
+	}
+
+	for _, key := range []string{"map_public_ip_on_launch", "map_customer_owned_ip_on_launch", "enable_resource_name_dns_a_record_on_launch"} {
+		if diff.Get(key).(bool) {
+			return fmt.Errorf("%q cannot be true for an IPv6-only subnet", key)
+		}
+	}
+
+	if v := diff.Get("private_dns_hostname_type_on_launch").(string); v == ec2.HostnameTypeIpName {
+		return fmt.Errorf(`"private_dns_hostname_type_on_launch" must be %q for an IPv6-only subnet`, ec2.HostnameTypeResourceName)
+	}
+
+	return nil
 }
 
 // modifySubnetAttributesOnCreate sets subnet attributes on resource Create.
@@ -955,6 +955,32 @@ func TestAccVPCSubnet_ipv6Native(t *testing.T) {
 	})
 }
 
+func TestAccVPCSubnet_IPv6Native_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubnetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeInvalid(rName, `cidr_block = "10.10.1.0/24"`),
+				ExpectError: regexache.MustCompile(`"cidr_block" cannot be set for an IPv6-only subnet`),
+			},
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeInvalid(rName, `map_public_ip_on_launch = true`),
+				ExpectError: regexache.MustCompile(`"map_public_ip_on_launch" cannot be true for an IPv6-only subnet`),
+			},
+			{
+				Config:      testAccVPCSubnetConfig_ipv6NativeInvalid(rName, `private_dns_hostname_type_on_launch = "ip-name"`),
+				ExpectError: regexache.MustCompile(`"private_dns_hostname_type_on_launch" must be "resource-name" for an IPv6-only subnet`),
+			},
+		},
+	})
+}
+
 func testAccCheckSubnetIPv6BeforeUpdate(subnet *ec2.Subnet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if subnet.Ipv6CidrBlockAssociationSet == nil {
@@ -1505,6 +1531,32 @@ resource "aws_subnet" "test" {
 `, rName)
 }
 
+func testAccVPCSubnetConfig_ipv6NativeInvalid(rName, extra string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.10.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id                          = aws_vpc.test.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+  assign_ipv6_address_on_creation = true
+  ipv6_native                     = true
+
+  %[2]s
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, extra)
+}
+
 func testAccVPCSubnetConfig_outpost(rName string) string {
 	return fmt.Sprintf(`
 data "aws_outposts_outposts" "test" {}
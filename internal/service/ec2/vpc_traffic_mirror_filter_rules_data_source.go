@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_ec2_traffic_mirror_filter_rules")
+func DataSourceTrafficMirrorFilterRules() *schema.Resource {
+	trafficMirrorPortRangeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from_port": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"to_port": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTrafficMirrorFilterRulesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_port_range": trafficMirrorPortRangeSchema(),
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rule_action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"source_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_port_range": trafficMirrorPortRangeSchema(),
+						"traffic_direction": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"traffic_direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.TrafficDirection_Values(), false),
+			},
+			"traffic_mirror_filter_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceTrafficMirrorFilterRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	filterID := d.Get("traffic_mirror_filter_id").(string)
+	filter, err := FindTrafficMirrorFilterByID(ctx, conn, filterID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Traffic Mirror Filter (%s): %s", filterID, err)
+	}
+
+	var rules []*ec2.TrafficMirrorFilterRule
+
+	switch d.Get("traffic_direction").(string) {
+	case ec2.TrafficDirectionIngress:
+		rules = filter.IngressFilterRules
+	case ec2.TrafficDirectionEgress:
+		rules = filter.EgressFilterRules
+	default:
+		rules = append(rules, filter.IngressFilterRules...)
+		rules = append(rules, filter.EgressFilterRules...)
+	}
+
+	var ruleIDs []string
+	var tfList []interface{}
+
+	for _, v := range rules {
+		if v == nil {
+			continue
+		}
+
+		ruleIDs = append(ruleIDs, aws.StringValue(v.TrafficMirrorFilterRuleId))
+		tfList = append(tfList, flattenTrafficMirrorFilterRule(v))
+	}
+
+	d.SetId(filterID)
+	d.Set("ids", ruleIDs)
+	if err := d.Set("rules", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rules: %s", err)
+	}
+
+	return diags
+}
+
+func flattenTrafficMirrorFilterRule(apiObject *ec2.TrafficMirrorFilterRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"description":            aws.StringValue(apiObject.Description),
+		"destination_cidr_block": aws.StringValue(apiObject.DestinationCidrBlock),
+		"id":                     aws.StringValue(apiObject.TrafficMirrorFilterRuleId),
+		"protocol":               aws.Int64Value(apiObject.Protocol),
+		"rule_action":            aws.StringValue(apiObject.RuleAction),
+		"rule_number":            aws.Int64Value(apiObject.RuleNumber),
+		"source_cidr_block":      aws.StringValue(apiObject.SourceCidrBlock),
+		"traffic_direction":      aws.StringValue(apiObject.TrafficDirection),
+	}
+
+	if v := apiObject.DestinationPortRange; v != nil {
+		tfMap["destination_port_range"] = []interface{}{flattenTrafficMirrorPortRange(v)}
+	}
+
+	if v := apiObject.SourcePortRange; v != nil {
+		tfMap["source_port_range"] = []interface{}{flattenTrafficMirrorPortRange(v)}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCTrafficMirrorFilterRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_traffic_mirror_filter_rules.test"
+	dataSourceNameIngress := "data.aws_ec2_traffic_mirror_filter_rules.ingress"
+	ingressRuleResourceName := "aws_ec2_traffic_mirror_filter_rule.ingress"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorFilterRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorFilterRulesDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceNameIngress, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceNameIngress, "ids.0", ingressRuleResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameIngress, "rules.0.id", ingressRuleResourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameIngress, "rules.0.description", ingressRuleResourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceNameIngress, "rules.0.destination_cidr_block", ingressRuleResourceName, "destination_cidr_block"),
+					resource.TestCheckResourceAttrPair(dataSourceNameIngress, "rules.0.destination_port_range.0.from_port", ingressRuleResourceName, "destination_port_range.0.from_port"),
+					resource.TestCheckResourceAttrPair(dataSourceNameIngress, "rules.0.protocol", ingressRuleResourceName, "protocol"),
+					resource.TestCheckResourceAttrPair(dataSourceNameIngress, "rules.0.rule_action", ingressRuleResourceName, "rule_action"),
+					resource.TestCheckResourceAttrPair(dataSourceNameIngress, "rules.0.rule_number", ingressRuleResourceName, "rule_number"),
+					resource.TestCheckResourceAttrPair(dataSourceNameIngress, "rules.0.source_cidr_block", ingressRuleResourceName, "source_cidr_block"),
+					resource.TestCheckResourceAttr(dataSourceNameIngress, "rules.0.traffic_direction", "ingress"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCTrafficMirrorFilterRulesDataSourceConfig_basic() string {
+	return `
+resource "aws_ec2_traffic_mirror_filter" "test" {
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "ingress" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  description              = "ingress rule"
+  destination_cidr_block   = "10.0.0.0/8"
+  source_cidr_block        = "0.0.0.0/0"
+  rule_number              = 1
+  rule_action              = "accept"
+  traffic_direction        = "ingress"
+  protocol                 = 6
+
+  destination_port_range {
+    from_port = 10000
+    to_port   = 10001
+  }
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "egress" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = "0.0.0.0/0"
+  source_cidr_block        = "10.0.0.0/8"
+  rule_number              = 1
+  rule_action              = "reject"
+  traffic_direction        = "egress"
+}
+
+data "aws_ec2_traffic_mirror_filter_rules" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+
+  depends_on = [
+    aws_ec2_traffic_mirror_filter_rule.ingress,
+    aws_ec2_traffic_mirror_filter_rule.egress,
+  ]
+}
+
+data "aws_ec2_traffic_mirror_filter_rules" "ingress" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  traffic_direction        = "ingress"
+
+  depends_on = [
+    aws_ec2_traffic_mirror_filter_rule.ingress,
+    aws_ec2_traffic_mirror_filter_rule.egress,
+  ]
+}
+`
+}
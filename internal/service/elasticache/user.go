@@ -5,6 +5,8 @@ package elasticache
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -39,7 +42,10 @@ func ResourceUser() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffUserAuthenticationMode,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -67,9 +73,11 @@ func ResourceUser() *schema.Resource {
 							Type:      schema.TypeSet,
 							Optional:  true,
 							MinItems:  1,
+							MaxItems:  2,
 							Sensitive: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(16, 128),
 							},
 						},
 						"password_count": {
@@ -388,6 +396,29 @@ func waitUserDeleted(ctx context.Context, conn *elasticache.ElastiCache, id stri
 	return nil, err
 }
 
+func customizeDiffUserAuthenticationMode(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// authentication_mode is Optional+Computed, so only validate it when it's configured.
+	if v := diff.GetRawConfig().GetAttr("authentication_mode"); v.IsNull() || !v.IsKnown() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	if diff.Get("authentication_mode.0.type").(string) != elasticache.InputAuthenticationTypeIam {
+		return nil
+	}
+
+	if diff.Get("authentication_mode.0.passwords").(*schema.Set).Len() > 0 || diff.Get("passwords").(*schema.Set).Len() > 0 {
+		return errors.New(`passwords must not be set when authentication_mode.type is "iam"`)
+	}
+
+	if diff.NewValueKnown("user_id") && diff.NewValueKnown("user_name") {
+		if userID, userName := diff.Get("user_id").(string), diff.Get("user_name").(string); userID != userName {
+			return fmt.Errorf(`user_name (%s) must be the same as user_id (%s) when authentication_mode.type is "iam"`, userName, userID)
+		}
+	}
+
+	return nil
+}
+
 func expandAuthenticationMode(tfMap map[string]interface{}) *elasticache.AuthenticationMode {
 	if tfMap == nil {
 		return nil
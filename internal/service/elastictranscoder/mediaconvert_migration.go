@@ -0,0 +1,457 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"golang.org/x/exp/slices"
+)
+
+// PresetMediaConvertMigration is an Elastic Transcoder preset translated to the shape of an AWS Elemental MediaConvert output.
+type PresetMediaConvertMigration struct {
+	// Name is the name of the Elastic Transcoder preset.
+	Name string
+	// Output is the MediaConvert output, in the JSON structure of the CreateJobTemplate API.
+	Output map[string]interface{}
+	// Unsupported describes the parts of the preset that need manual migration.
+	Unsupported []string
+}
+
+// PipelineMediaConvertMigration is an Elastic Transcoder pipeline and its presets translated to the shape of an
+// AWS Elemental MediaConvert CreateJobTemplate request.
+type PipelineMediaConvertMigration struct {
+	// Name is the name of the Elastic Transcoder pipeline.
+	Name string
+	// Role is the IAM role of the Elastic Transcoder pipeline.
+	Role string
+	// JobTemplate is the MediaConvert job template, in the JSON structure of the CreateJobTemplate API.
+	JobTemplate map[string]interface{}
+	// Unsupported describes the parts of the pipeline and presets that need manual migration.
+	Unsupported []string
+}
+
+// MigratePreset translates the Elastic Transcoder preset with the specified ID to a MediaConvert output.
+func MigratePreset(ctx context.Context, conn *elastictranscoder.ElasticTranscoder, presetID string) (*PresetMediaConvertMigration, error) {
+	output, err := conn.ReadPresetWithContext(ctx, &elastictranscoder.ReadPresetInput{
+		Id: aws.String(presetID),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Elastic Transcoder Preset (%s): %w", presetID, err)
+	}
+
+	if output == nil || output.Preset == nil {
+		return nil, fmt.Errorf("reading Elastic Transcoder Preset (%s): empty result", presetID)
+	}
+
+	return migratePreset(output.Preset), nil
+}
+
+// MigratePipeline translates the Elastic Transcoder pipeline with the specified ID, and the specified presets,
+// to a MediaConvert job template with one file group output per preset.
+// The output destination is built from the pipeline's output bucket and the specified key prefix.
+func MigratePipeline(ctx context.Context, conn *elastictranscoder.ElasticTranscoder, pipelineID string, presetIDs []string, outputKeyPrefix string) (*PipelineMediaConvertMigration, error) {
+	output, err := conn.ReadPipelineWithContext(ctx, &elastictranscoder.ReadPipelineInput{
+		Id: aws.String(pipelineID),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Elastic Transcoder Pipeline (%s): %w", pipelineID, err)
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return nil, fmt.Errorf("reading Elastic Transcoder Pipeline (%s): empty result", pipelineID)
+	}
+
+	pipeline := output.Pipeline
+	migration := &PipelineMediaConvertMigration{
+		Name: aws.StringValue(pipeline.Name),
+		Role: aws.StringValue(pipeline.Role),
+	}
+
+	bucket := aws.StringValue(pipeline.OutputBucket)
+	if bucket == "" && pipeline.ContentConfig != nil {
+		bucket = aws.StringValue(pipeline.ContentConfig.Bucket)
+	}
+
+	if pipeline.ThumbnailConfig != nil && aws.StringValue(pipeline.ThumbnailConfig.Bucket) != bucket {
+		migration.Unsupported = append(migration.Unsupported, fmt.Sprintf("pipeline %s: thumbnail bucket %s", pipelineID, aws.StringValue(pipeline.ThumbnailConfig.Bucket)))
+	}
+
+	if v := pipeline.Notifications; v != nil && (aws.StringValue(v.Completed) != "" || aws.StringValue(v.Error) != "" || aws.StringValue(v.Progressing) != "" || aws.StringValue(v.Warning) != "") {
+		migration.Unsupported = append(migration.Unsupported, fmt.Sprintf("pipeline %s: SNS notifications, use Amazon EventBridge rules for MediaConvert job state changes", pipelineID))
+	}
+
+	if aws.StringValue(pipeline.AwsKmsKeyArn) != "" {
+		migration.Unsupported = append(migration.Unsupported, fmt.Sprintf("pipeline %s: KMS key %s", pipelineID, aws.StringValue(pipeline.AwsKmsKeyArn)))
+	}
+
+	var outputs []interface{}
+	hasAudio := false
+
+	for _, presetID := range presetIDs {
+		preset, err := MigratePreset(ctx, conn, presetID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		migration.Unsupported = append(migration.Unsupported, preset.Unsupported...)
+
+		if preset.Output == nil {
+			continue
+		}
+
+		// Each output in a file group needs a unique name modifier.
+		preset.Output["NameModifier"] = "_" + presetID
+
+		if _, ok := preset.Output["AudioDescriptions"]; ok {
+			hasAudio = true
+		}
+
+		outputs = append(outputs, preset.Output)
+	}
+
+	destination := "s3://" + bucket + "/"
+	if outputKeyPrefix != "" {
+		destination += strings.TrimPrefix(outputKeyPrefix, "/")
+	}
+
+	settings := map[string]interface{}{
+		"OutputGroups": []interface{}{
+			map[string]interface{}{
+				"Name": "File Group",
+				"OutputGroupSettings": map[string]interface{}{
+					"Type": "FILE_GROUP_SETTINGS",
+					"FileGroupSettings": map[string]interface{}{
+						"Destination": destination,
+					},
+				},
+				"Outputs": outputs,
+			},
+		},
+	}
+
+	input := map[string]interface{}{
+		"VideoSelector": map[string]interface{}{},
+	}
+
+	if hasAudio {
+		input["AudioSelectors"] = map[string]interface{}{
+			"Audio Selector 1": map[string]interface{}{
+				"DefaultSelection": "DEFAULT",
+			},
+		}
+	}
+
+	settings["Inputs"] = []interface{}{input}
+
+	migration.JobTemplate = map[string]interface{}{
+		"Description": fmt.Sprintf("Migrated from Elastic Transcoder pipeline %s", pipelineID),
+		"Name":        aws.StringValue(pipeline.Name),
+		"Settings":    settings,
+	}
+
+	return migration, nil
+}
+
+func migratePreset(preset *elastictranscoder.Preset) *PresetMediaConvertMigration {
+	presetID := aws.StringValue(preset.Id)
+	migration := &PresetMediaConvertMigration{
+		Name: aws.StringValue(preset.Name),
+	}
+	unsupported := func(format string, a ...interface{}) {
+		migration.Unsupported = append(migration.Unsupported, fmt.Sprintf("preset %s: ", presetID)+fmt.Sprintf(format, a...))
+	}
+
+	container, ok := map[string]string{
+		"flac": "RAW",
+		"fmp4": "CMFC",
+		"mp3":  "RAW",
+		"mp4":  "MP4",
+		"mxf":  "MXF",
+		"ts":   "M2TS",
+		"wav":  "RAW",
+		"webm": "WEBM",
+	}[aws.StringValue(preset.Container)]
+
+	if !ok {
+		unsupported("container %s", aws.StringValue(preset.Container))
+
+		return migration
+	}
+
+	output := map[string]interface{}{
+		"ContainerSettings": map[string]interface{}{
+			"Container": container,
+		},
+	}
+
+	if preset.Video != nil {
+		if v := migrateVideoParameters(preset.Video, unsupported); v != nil {
+			output["VideoDescription"] = v
+		}
+	}
+
+	if preset.Audio != nil {
+		if v := migrateAudioParameters(preset.Audio, unsupported); v != nil {
+			output["AudioDescriptions"] = []interface{}{v}
+		}
+	}
+
+	if preset.Thumbnails != nil {
+		unsupported("thumbnails, add a frame capture output group to the job template")
+	}
+
+	migration.Output = output
+
+	return migration
+}
+
+func migrateVideoParameters(apiObject *elastictranscoder.VideoParameters, unsupported func(string, ...interface{})) map[string]interface{} {
+	codecOptions := aws.StringValueMap(apiObject.CodecOptions)
+	codecSettings := map[string]interface{}{}
+
+	switch codec := aws.StringValue(apiObject.Codec); codec {
+	case "H.264":
+		settings := map[string]interface{}{}
+
+		if v, ok := bitsPerSecond(aws.StringValue(apiObject.BitRate)); ok {
+			settings["RateControlMode"] = "CBR"
+			settings["Bitrate"] = v
+		} else {
+			settings["RateControlMode"] = "QVBR"
+			settings["QvbrSettings"] = map[string]interface{}{
+				"QvbrQualityLevel": 7,
+			}
+
+			if v, ok := bitsPerSecond(codecOptions["MaxBitRate"]); ok {
+				settings["MaxBitrate"] = v
+			} else {
+				unsupported("automatic video bit rate, set MaxBitrate for QVBR rate control")
+			}
+		}
+
+		if v, ok := map[string]string{
+			"baseline": "BASELINE",
+			"high":     "HIGH",
+			"main":     "MAIN",
+		}[codecOptions["Profile"]]; ok {
+			settings["CodecProfile"] = v
+		}
+
+		switch v := codecOptions["Level"]; v {
+		case "":
+		case "1b":
+			// MediaConvert has no level 1b, so use the next level up, which supports its bit rate.
+			settings["CodecLevel"] = mediaconvert.H264CodecLevelLevel11
+			unsupported("H.264 level 1b, using %s", mediaconvert.H264CodecLevelLevel11)
+		default:
+			if level := "LEVEL_" + strings.ReplaceAll(v, ".", "_"); slices.Contains(mediaconvert.H264CodecLevel_Values(), level) {
+				settings["CodecLevel"] = level
+			} else {
+				unsupported("H.264 level %s", v)
+			}
+		}
+
+		if v, err := strconv.Atoi(aws.StringValue(apiObject.KeyframesMaxDist)); err == nil {
+			settings["GopSize"] = v
+			settings["GopSizeUnits"] = "FRAMES"
+		}
+
+		if aws.StringValue(apiObject.FixedGOP) == "true" {
+			settings["GopClosedCadence"] = 1
+		}
+
+		migrateFrameRate(settings, aws.StringValue(apiObject.FrameRate))
+
+		codecSettings["Codec"] = "H_264"
+		codecSettings["H264Settings"] = settings
+	case "mpeg2", "vp8", "vp9":
+		settings := map[string]interface{}{}
+		// Codec name and settings key.
+		v := map[string][2]string{
+			"mpeg2": {"MPEG2", "Mpeg2Settings"},
+			"vp8":   {"VP8", "Vp8Settings"},
+			"vp9":   {"VP9", "Vp9Settings"},
+		}[codec]
+
+		if v, ok := bitsPerSecond(aws.StringValue(apiObject.BitRate)); ok {
+			settings["Bitrate"] = v
+		} else {
+			unsupported("automatic video bit rate for %s, set Bitrate", codec)
+		}
+
+		migrateFrameRate(settings, aws.StringValue(apiObject.FrameRate))
+
+		codecSettings["Codec"] = v[0]
+		codecSettings[v[1]] = settings
+	default:
+		unsupported("video codec %s", codec)
+
+		return nil
+	}
+
+	apiObjectOut := map[string]interface{}{
+		"CodecSettings": codecSettings,
+	}
+
+	// MediaConvert either stretches the input to the output size or scales it to fit, letterboxing it.
+	// Without an output size, the input's resolution is used.
+	sizingPolicy := aws.StringValue(apiObject.SizingPolicy)
+	width, widthErr := strconv.Atoi(aws.StringValue(apiObject.MaxWidth))
+	height, heightErr := strconv.Atoi(aws.StringValue(apiObject.MaxHeight))
+
+	if sizingPolicy == "Keep" {
+		if widthErr == nil || heightErr == nil {
+			unsupported("sizing policy Keep with a maximum width or height, the input's resolution is used without cropping")
+		}
+	} else {
+		if widthErr == nil {
+			apiObjectOut["Width"] = width
+		}
+
+		if heightErr == nil {
+			apiObjectOut["Height"] = height
+		}
+	}
+
+	switch sizingPolicy {
+	case "Stretch":
+		apiObjectOut["ScalingBehavior"] = mediaconvert.ScalingBehaviorStretchToOutput
+	case "Fill", "ShrinkToFill", "ShrinkToFit":
+		unsupported("sizing policy %s, scaling to fit the output size instead", sizingPolicy)
+		apiObjectOut["ScalingBehavior"] = mediaconvert.ScalingBehaviorDefault
+	default:
+		apiObjectOut["ScalingBehavior"] = mediaconvert.ScalingBehaviorDefault
+	}
+
+	if len(apiObject.Watermarks) > 0 {
+		unsupported("video watermarks, use an image inserter in the job template")
+	}
+
+	return apiObjectOut
+}
+
+func migrateAudioParameters(apiObject *elastictranscoder.AudioParameters, unsupported func(string, ...interface{})) map[string]interface{} {
+	settings := map[string]interface{}{}
+
+	if v, ok := bitsPerSecond(aws.StringValue(apiObject.BitRate)); ok {
+		settings["Bitrate"] = v
+	}
+
+	if v, err := strconv.Atoi(aws.StringValue(apiObject.SampleRate)); err == nil {
+		settings["SampleRate"] = v
+	}
+
+	channels, _ := strconv.Atoi(aws.StringValue(apiObject.Channels))
+	codecSettings := map[string]interface{}{}
+
+	switch codec := aws.StringValue(apiObject.Codec); codec {
+	case "AAC":
+		switch channels {
+		case 1:
+			settings["CodingMode"] = "CODING_MODE_1_0"
+		default:
+			settings["CodingMode"] = "CODING_MODE_2_0"
+		}
+
+		if apiObject.CodecOptions != nil {
+			if v, ok := map[string]string{
+				"AAC-LC":   "LC",
+				"HE-AAC":   "HEV1",
+				"HE-AACv2": "HEV2",
+			}[aws.StringValue(apiObject.CodecOptions.Profile)]; ok {
+				settings["CodecProfile"] = v
+			}
+		}
+
+		codecSettings["Codec"] = "AAC"
+		codecSettings["AacSettings"] = settings
+	case "flac", "mp3", "pcm", "vorbis":
+		// Codec name and settings key.
+		v := map[string][2]string{
+			"flac":   {"FLAC", "FlacSettings"},
+			"mp3":    {"MP3", "Mp3Settings"},
+			"pcm":    {"WAV", "WavSettings"},
+			"vorbis": {"VORBIS", "VorbisSettings"},
+		}[codec]
+
+		if channels > 0 {
+			settings["Channels"] = channels
+		}
+
+		if codec == "pcm" {
+			delete(settings, "Bitrate")
+
+			if apiObject.CodecOptions != nil {
+				if v, err := strconv.Atoi(aws.StringValue(apiObject.CodecOptions.BitDepth)); err == nil {
+					settings["BitDepth"] = v
+				}
+			}
+		}
+
+		codecSettings["Codec"] = v[0]
+		codecSettings[v[1]] = settings
+	default:
+		unsupported("audio codec %s", codec)
+
+		return nil
+	}
+
+	return map[string]interface{}{
+		"AudioSourceName": "Audio Selector 1",
+		"CodecSettings":   codecSettings,
+	}
+}
+
+// migrateFrameRate sets the MediaConvert frame rate settings for the specified Elastic Transcoder frame rate.
+func migrateFrameRate(settings map[string]interface{}, frameRate string) {
+	numerator, denominator := 0, 1
+
+	switch frameRate {
+	case "", "auto":
+		settings["FramerateControl"] = "INITIALIZE_FROM_SOURCE"
+
+		return
+	case "23.97":
+		numerator, denominator = 24000, 1001
+	case "29.97":
+		numerator, denominator = 30000, 1001
+	case "59.94":
+		numerator, denominator = 60000, 1001
+	default:
+		v, err := strconv.Atoi(frameRate)
+
+		if err != nil {
+			settings["FramerateControl"] = "INITIALIZE_FROM_SOURCE"
+
+			return
+		}
+
+		numerator = v
+	}
+
+	settings["FramerateControl"] = "SPECIFIED"
+	settings["FramerateNumerator"] = numerator
+	settings["FramerateDenominator"] = denominator
+}
+
+// bitsPerSecond converts an Elastic Transcoder bit rate in kilobits per second to bits per second.
+func bitsPerSecond(kbps string) (int, bool) {
+	v, err := strconv.Atoi(kbps)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return v * 1000, true
+}
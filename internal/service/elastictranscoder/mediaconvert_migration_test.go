@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMigratePreset(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		preset          *elastictranscoder.Preset
+		wantOutput      map[string]interface{}
+		wantUnsupported []string
+	}{
+		"unsupported container": {
+			preset: &elastictranscoder.Preset{
+				Container: aws.String("gif"),
+				Id:        aws.String("p1"),
+				Name:      aws.String("gif"),
+			},
+			wantUnsupported: []string{"preset p1: container gif"},
+		},
+		"audio only": {
+			preset: &elastictranscoder.Preset{
+				Audio: &elastictranscoder.AudioParameters{
+					BitRate:    aws.String("128"),
+					Channels:   aws.String("2"),
+					Codec:      aws.String("mp3"),
+					SampleRate: aws.String("44100"),
+				},
+				Container: aws.String("mp3"),
+				Id:        aws.String("p1"),
+				Name:      aws.String("mp3"),
+			},
+			wantOutput: map[string]interface{}{
+				"AudioDescriptions": []interface{}{
+					map[string]interface{}{
+						"AudioSourceName": "Audio Selector 1",
+						"CodecSettings": map[string]interface{}{
+							"Codec": "MP3",
+							"Mp3Settings": map[string]interface{}{
+								"Bitrate":    128000,
+								"Channels":   2,
+								"SampleRate": 44100,
+							},
+						},
+					},
+				},
+				"ContainerSettings": map[string]interface{}{
+					"Container": "RAW",
+				},
+			},
+		},
+		"thumbnails": {
+			preset: &elastictranscoder.Preset{
+				Container:  aws.String("mp4"),
+				Id:         aws.String("p1"),
+				Name:       aws.String("mp4"),
+				Thumbnails: &elastictranscoder.Thumbnails{},
+			},
+			wantOutput: map[string]interface{}{
+				"ContainerSettings": map[string]interface{}{
+					"Container": "MP4",
+				},
+			},
+			wantUnsupported: []string{"preset p1: thumbnails, add a frame capture output group to the job template"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := migratePreset(testCase.preset)
+
+			if diff := cmp.Diff(got.Output, testCase.wantOutput); diff != "" {
+				t.Errorf("unexpected output diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Unsupported, testCase.wantUnsupported); diff != "" {
+				t.Errorf("unexpected unsupported diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMigrateVideoParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		video           *elastictranscoder.VideoParameters
+		want            map[string]interface{}
+		wantUnsupported []string
+	}{
+		"H.264 CBR": {
+			video: &elastictranscoder.VideoParameters{
+				BitRate:          aws.String("2200"),
+				Codec:            aws.String("H.264"),
+				CodecOptions:     aws.StringMap(map[string]string{"Level": "4.1", "Profile": "main"}),
+				FixedGOP:         aws.String("true"),
+				FrameRate:        aws.String("29.97"),
+				KeyframesMaxDist: aws.String("90"),
+				MaxHeight:        aws.String("720"),
+				MaxWidth:         aws.String("1280"),
+				SizingPolicy:     aws.String("Fit"),
+			},
+			want: map[string]interface{}{
+				"CodecSettings": map[string]interface{}{
+					"Codec": "H_264",
+					"H264Settings": map[string]interface{}{
+						"Bitrate":              2200000,
+						"CodecLevel":           "LEVEL_4_1",
+						"CodecProfile":         "MAIN",
+						"FramerateControl":     "SPECIFIED",
+						"FramerateDenominator": 1001,
+						"FramerateNumerator":   30000,
+						"GopClosedCadence":     1,
+						"GopSize":              90,
+						"GopSizeUnits":         "FRAMES",
+						"RateControlMode":      "CBR",
+					},
+				},
+				"Height":          720,
+				"ScalingBehavior": "DEFAULT",
+				"Width":           1280,
+			},
+		},
+		"H.264 automatic bit rate": {
+			video: &elastictranscoder.VideoParameters{
+				BitRate:      aws.String("auto"),
+				Codec:        aws.String("H.264"),
+				CodecOptions: aws.StringMap(map[string]string{"MaxBitRate": "5000"}),
+				FrameRate:    aws.String("auto"),
+				SizingPolicy: aws.String("Stretch"),
+			},
+			want: map[string]interface{}{
+				"CodecSettings": map[string]interface{}{
+					"Codec": "H_264",
+					"H264Settings": map[string]interface{}{
+						"FramerateControl": "INITIALIZE_FROM_SOURCE",
+						"MaxBitrate":       5000000,
+						"QvbrSettings": map[string]interface{}{
+							"QvbrQualityLevel": 7,
+						},
+						"RateControlMode": "QVBR",
+					},
+				},
+				"ScalingBehavior": "STRETCH_TO_OUTPUT",
+			},
+		},
+		"H.264 level 1b": {
+			video: &elastictranscoder.VideoParameters{
+				BitRate:      aws.String("128"),
+				Codec:        aws.String("H.264"),
+				CodecOptions: aws.StringMap(map[string]string{"Level": "1b"}),
+			},
+			want: map[string]interface{}{
+				"CodecSettings": map[string]interface{}{
+					"Codec": "H_264",
+					"H264Settings": map[string]interface{}{
+						"Bitrate":          128000,
+						"CodecLevel":       "LEVEL_1_1",
+						"FramerateControl": "INITIALIZE_FROM_SOURCE",
+						"RateControlMode":  "CBR",
+					},
+				},
+				"ScalingBehavior": "DEFAULT",
+			},
+			wantUnsupported: []string{"H.264 level 1b, using LEVEL_1_1"},
+		},
+		"keep sizing policy": {
+			video: &elastictranscoder.VideoParameters{
+				BitRate:      aws.String("1000"),
+				Codec:        aws.String("vp9"),
+				FrameRate:    aws.String("30"),
+				MaxHeight:    aws.String("720"),
+				MaxWidth:     aws.String("1280"),
+				SizingPolicy: aws.String("Keep"),
+			},
+			want: map[string]interface{}{
+				"CodecSettings": map[string]interface{}{
+					"Codec": "VP9",
+					"Vp9Settings": map[string]interface{}{
+						"Bitrate":              1000000,
+						"FramerateControl":     "SPECIFIED",
+						"FramerateDenominator": 1,
+						"FramerateNumerator":   30,
+					},
+				},
+				"ScalingBehavior": "DEFAULT",
+			},
+			wantUnsupported: []string{"sizing policy Keep with a maximum width or height, the input's resolution is used without cropping"},
+		},
+		"fill sizing policy": {
+			video: &elastictranscoder.VideoParameters{
+				BitRate:      aws.String("1000"),
+				Codec:        aws.String("mpeg2"),
+				MaxHeight:    aws.String("auto"),
+				MaxWidth:     aws.String("640"),
+				SizingPolicy: aws.String("Fill"),
+			},
+			want: map[string]interface{}{
+				"CodecSettings": map[string]interface{}{
+					"Codec": "MPEG2",
+					"Mpeg2Settings": map[string]interface{}{
+						"Bitrate":          1000000,
+						"FramerateControl": "INITIALIZE_FROM_SOURCE",
+					},
+				},
+				"ScalingBehavior": "DEFAULT",
+				"Width":           640,
+			},
+			wantUnsupported: []string{"sizing policy Fill, scaling to fit the output size instead"},
+		},
+		"unsupported codec": {
+			video: &elastictranscoder.VideoParameters{
+				Codec: aws.String("gif"),
+			},
+			wantUnsupported: []string{"video codec gif"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotUnsupported []string
+			got := migrateVideoParameters(testCase.video, func(format string, a ...interface{}) {
+				gotUnsupported = append(gotUnsupported, fmt.Sprintf(format, a...))
+			})
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(gotUnsupported, testCase.wantUnsupported); diff != "" {
+				t.Errorf("unexpected unsupported diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMigrateAudioParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		audio           *elastictranscoder.AudioParameters
+		want            map[string]interface{}
+		wantUnsupported []string
+	}{
+		"AAC mono": {
+			audio: &elastictranscoder.AudioParameters{
+				BitRate:      aws.String("64"),
+				Channels:     aws.String("1"),
+				Codec:        aws.String("AAC"),
+				CodecOptions: &elastictranscoder.AudioCodecOptions{Profile: aws.String("HE-AAC")},
+				SampleRate:   aws.String("48000"),
+			},
+			want: map[string]interface{}{
+				"AudioSourceName": "Audio Selector 1",
+				"CodecSettings": map[string]interface{}{
+					"AacSettings": map[string]interface{}{
+						"Bitrate":      64000,
+						"CodecProfile": "HEV1",
+						"CodingMode":   "CODING_MODE_1_0",
+						"SampleRate":   48000,
+					},
+					"Codec": "AAC",
+				},
+			},
+		},
+		"AAC automatic channels": {
+			audio: &elastictranscoder.AudioParameters{
+				BitRate:    aws.String("160"),
+				Channels:   aws.String("auto"),
+				Codec:      aws.String("AAC"),
+				SampleRate: aws.String("auto"),
+			},
+			want: map[string]interface{}{
+				"AudioSourceName": "Audio Selector 1",
+				"CodecSettings": map[string]interface{}{
+					"AacSettings": map[string]interface{}{
+						"Bitrate":    160000,
+						"CodingMode": "CODING_MODE_2_0",
+					},
+					"Codec": "AAC",
+				},
+			},
+		},
+		"PCM": {
+			audio: &elastictranscoder.AudioParameters{
+				BitRate:      aws.String("1411"),
+				Channels:     aws.String("2"),
+				Codec:        aws.String("pcm"),
+				CodecOptions: &elastictranscoder.AudioCodecOptions{BitDepth: aws.String("16")},
+				SampleRate:   aws.String("44100"),
+			},
+			want: map[string]interface{}{
+				"AudioSourceName": "Audio Selector 1",
+				"CodecSettings": map[string]interface{}{
+					"Codec": "WAV",
+					"WavSettings": map[string]interface{}{
+						"BitDepth":   16,
+						"Channels":   2,
+						"SampleRate": 44100,
+					},
+				},
+			},
+		},
+		"unsupported codec": {
+			audio: &elastictranscoder.AudioParameters{
+				Codec: aws.String("opus"),
+			},
+			wantUnsupported: []string{"audio codec opus"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotUnsupported []string
+			got := migrateAudioParameters(testCase.audio, func(format string, a ...interface{}) {
+				gotUnsupported = append(gotUnsupported, fmt.Sprintf(format, a...))
+			})
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(gotUnsupported, testCase.wantUnsupported); diff != "" {
+				t.Errorf("unexpected unsupported diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_elastictranscoder_pipeline_mediaconvert_migration")
+func DataSourcePipelineMediaConvertMigration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePipelineMediaConvertMigrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"mediaconvert_job_template_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pipeline_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"preset_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unsupported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePipelineMediaConvertMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticTranscoderConn(ctx)
+
+	pipelineID := d.Get("pipeline_id").(string)
+	presetIDs := flex.ExpandStringValueList(d.Get("preset_ids").([]interface{}))
+	migration, err := MigratePipeline(ctx, conn, pipelineID, presetIDs, d.Get("output_key_prefix").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "migrating Elastic Transcoder Pipeline (%s): %s", pipelineID, err)
+	}
+
+	v, err := json.Marshal(migration.JobTemplate)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "migrating Elastic Transcoder Pipeline (%s): %s", pipelineID, err)
+	}
+
+	d.SetId(pipelineID)
+	d.Set("mediaconvert_job_template_json", string(v))
+	d.Set("name", migration.Name)
+	d.Set("role", migration.Role)
+	d.Set("unsupported", migration.Unsupported)
+
+	return diags
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElasticTranscoderPipelineMediaConvertMigrationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastictranscoder_pipeline.test"
+	dataSourceName := "data.aws_elastictranscoder_pipeline_mediaconvert_migration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elastictranscoder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineMediaConvertMigrationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role", resourceName, "role"),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported.#", "0"),
+					resource.TestMatchResourceAttr(dataSourceName, "mediaconvert_job_template_json", regexache.MustCompile(fmt.Sprintf(`"Destination":"s3://%s/output/"`, rName))),
+					resource.TestMatchResourceAttr(dataSourceName, "mediaconvert_job_template_json", regexache.MustCompile(`"Audio Selector 1"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "mediaconvert_job_template_json", regexache.MustCompile(`"Mp3Settings":\{"Bitrate":320000,"Channels":2,"SampleRate":44100\}`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccPipelineMediaConvertMigrationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfig_basic(rName), fmt.Sprintf(`
+resource "aws_elastictranscoder_preset" "test" {
+  container = "mp3"
+  name      = %[1]q
+
+  audio {
+    audio_packing_mode = "SingleTrack"
+    bit_rate           = 320
+    channels           = 2
+    codec              = "mp3"
+    sample_rate        = 44100
+  }
+}
+
+data "aws_elastictranscoder_pipeline_mediaconvert_migration" "test" {
+  pipeline_id       = aws_elastictranscoder_pipeline.test.id
+  preset_ids        = [aws_elastictranscoder_preset.test.id]
+  output_key_prefix = "output/"
+}
+`, rName))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_elastictranscoder_preset_mediaconvert_migration")
+func DataSourcePresetMediaConvertMigration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePresetMediaConvertMigrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"mediaconvert_output_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"preset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"unsupported": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePresetMediaConvertMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticTranscoderConn(ctx)
+
+	presetID := d.Get("preset_id").(string)
+	migration, err := MigratePreset(ctx, conn, presetID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "migrating Elastic Transcoder Preset (%s): %s", presetID, err)
+	}
+
+	d.SetId(presetID)
+	d.Set("name", migration.Name)
+	d.Set("unsupported", migration.Unsupported)
+
+	if migration.Output != nil {
+		v, err := json.Marshal(migration.Output)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "migrating Elastic Transcoder Preset (%s): %s", presetID, err)
+		}
+
+		d.Set("mediaconvert_output_json", string(v))
+	} else {
+		d.Set("mediaconvert_output_json", nil)
+	}
+
+	return diags
+}
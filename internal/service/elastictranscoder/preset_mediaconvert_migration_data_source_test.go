@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elastictranscoder_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElasticTranscoderPresetMediaConvertMigrationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastictranscoder_preset.test"
+	dataSourceName := "data.aws_elastictranscoder_preset_mediaconvert_migration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elastictranscoder.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetMediaConvertMigrationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported.#", "2"),
+					resource.TestMatchResourceAttr(dataSourceName, "unsupported.0", regexache.MustCompile(`automatic video bit rate`)),
+					resource.TestMatchResourceAttr(dataSourceName, "unsupported.1", regexache.MustCompile(`thumbnails`)),
+					resource.TestMatchResourceAttr(dataSourceName, "mediaconvert_output_json", regexache.MustCompile(`"Container":"MP4"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "mediaconvert_output_json", regexache.MustCompile(`"Codec":"H_264"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "mediaconvert_output_json", regexache.MustCompile(`"CodecLevel":"LEVEL_4_1"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "mediaconvert_output_json", regexache.MustCompile(`"AacSettings":\{"Bitrate":128000,"CodingMode":"CODING_MODE_2_0","SampleRate":48000\}`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccPresetMediaConvertMigrationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPresetConfig_full1(rName), `
+data "aws_elastictranscoder_preset_mediaconvert_migration" "test" {
+  preset_id = aws_elastictranscoder_preset.test.id
+}
+`)
+}
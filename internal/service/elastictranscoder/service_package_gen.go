@@ -24,7 +24,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourcePipelineMediaConvertMigration,
+			TypeName: "aws_elastictranscoder_pipeline_mediaconvert_migration",
+		},
+		{
+			Factory:  DataSourcePresetMediaConvertMigration,
+			TypeName: "aws_elastictranscoder_preset_mediaconvert_migration",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -53,6 +53,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceTargetGroup,
 			TypeName: "aws_lb_target_group",
 		},
+		{
+			Factory:  DataSourceTargetHealth,
+			TypeName: "aws_lb_target_health",
+		},
 		{
 			Factory:  DataSourceLoadBalancers,
 			TypeName: "aws_lbs",
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_lb_target_health")
+func DataSourceTargetHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTargetHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"all_healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"healthy_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"target_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_health_descriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"unhealthy_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTargetHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	targetGroupARN := d.Get("target_group_arn").(string)
+	input := &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	}
+
+	if v, ok := d.GetOk("target"); ok && len(v.([]interface{})) > 0 {
+		input.Targets = expandTargetDescriptions(v.([]interface{}))
+	}
+
+	output, err := FindTargetHealthDescriptions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Target Group (%s) target health: %s", targetGroupARN, err)
+	}
+
+	var healthy, unhealthy int
+
+	for _, v := range output {
+		if v.TargetHealth == nil {
+			continue
+		}
+
+		switch aws.StringValue(v.TargetHealth.State) {
+		case elbv2.TargetHealthStateEnumHealthy:
+			healthy++
+		case elbv2.TargetHealthStateEnumUnhealthy, elbv2.TargetHealthStateEnumUnavailable:
+			unhealthy++
+		}
+	}
+
+	d.SetId(targetGroupARN)
+	d.Set("all_healthy", len(output) > 0 && healthy == len(output))
+	d.Set("healthy_count", healthy)
+	if err := d.Set("target_health_descriptions", flattenTargetHealthDescriptions(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_health_descriptions: %s", err)
+	}
+	d.Set("unhealthy_count", unhealthy)
+
+	return diags
+}
+
+func expandTargetDescriptions(tfList []interface{}) []*elbv2.TargetDescription {
+	var apiObjects []*elbv2.TargetDescription
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &elbv2.TargetDescription{
+			Id: aws.String(tfMap["id"].(string)),
+		}
+
+		if v, ok := tfMap["availability_zone"].(string); ok && v != "" {
+			apiObject.AvailabilityZone = aws.String(v)
+		}
+
+		if v, ok := tfMap["port"].(int); ok && v != 0 {
+			apiObject.Port = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTargetHealthDescriptions(apiObjects []*elbv2.TargetHealthDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"health_check_port": aws.StringValue(apiObject.HealthCheckPort),
+		}
+
+		if v := apiObject.Target; v != nil {
+			tfMap["availability_zone"] = aws.StringValue(v.AvailabilityZone)
+			tfMap["target_id"] = aws.StringValue(v.Id)
+			tfMap["target_port"] = aws.Int64Value(v.Port)
+		}
+
+		if v := apiObject.TargetHealth; v != nil {
+			tfMap["description"] = aws.StringValue(v.Description)
+			tfMap["reason"] = aws.StringValue(v.Reason)
+			tfMap["state"] = aws.StringValue(v.State)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccELBV2TargetHealthDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_target_health.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetHealthDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_group_arn", "aws_lb_target_group.test", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "all_healthy", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "healthy_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "unhealthy_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_health_descriptions.0.target_id", "aws_instance.test", "private_ip"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.target_port", "443"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.state", elbv2.TargetHealthStateEnumUnused),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.reason", elbv2.TargetHealthReasonEnumTargetNotInUse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBV2TargetHealthDataSource_target(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_target_health.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elbv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetHealthDataSourceConfig_target(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_health_descriptions.0.target_id", "aws_instance.test", "private_ip"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_health_descriptions.0.availability_zone", "aws_instance.test", "availability_zone"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTargetHealthDataSourceConfig_base(rName string) string {
+	return testAccTargetGroupAttachmentInstanceBaseConfig() + fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  name        = %[1]q
+  port        = 443
+  protocol    = "HTTPS"
+  target_type = "ip"
+  vpc_id      = aws_vpc.test.id
+}
+
+resource "aws_lb_target_group_attachment" "test" {
+  availability_zone = aws_instance.test.availability_zone
+  target_group_arn  = aws_lb_target_group.test.arn
+  target_id         = aws_instance.test.private_ip
+}
+`, rName)
+}
+
+func testAccTargetHealthDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTargetHealthDataSourceConfig_base(rName), `
+data "aws_lb_target_health" "test" {
+  target_group_arn = aws_lb_target_group_attachment.test.target_group_arn
+}
+`)
+}
+
+func testAccTargetHealthDataSourceConfig_target(rName string) string {
+	return acctest.ConfigCompose(testAccTargetHealthDataSourceConfig_base(rName), `
+data "aws_lb_target_health" "test" {
+  target_group_arn = aws_lb_target_group_attachment.test.target_group_arn
+
+  target {
+    id                = aws_instance.test.private_ip
+    availability_zone = aws_instance.test.availability_zone
+  }
+}
+`)
+}
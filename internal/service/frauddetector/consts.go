@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+const (
+	modelVersionStatusActivateInProgress   = "ACTIVATE_IN_PROGRESS"
+	modelVersionStatusActivateRequested    = "ACTIVATE_REQUESTED"
+	modelVersionStatusActive               = "ACTIVE"
+	modelVersionStatusInactivateInProgress = "INACTIVATE_IN_PROGRESS"
+	modelVersionStatusInactivateRequested  = "INACTIVATE_REQUESTED"
+	modelVersionStatusInactive             = "INACTIVE"
+	modelVersionStatusTrainingComplete     = "TRAINING_COMPLETE"
+	modelVersionStatusTrainingInProgress   = "TRAINING_IN_PROGRESS"
+)
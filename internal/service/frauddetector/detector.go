@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_detector", name="Detector")
+// @Tags(identifierAttribute="arn")
+func ResourceDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDetectorPut,
+		ReadWithoutTimeout:   resourceDetectorRead,
+		UpdateWithoutTimeout: resourceDetectorPut,
+		DeleteWithoutTimeout: resourceDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"event_type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDetectorPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	if d.IsNewResource() || d.HasChangesExcept("tags", "tags_all") {
+		detectorID := d.Get("detector_id").(string)
+		input := &frauddetector.PutDetectorInput{
+			DetectorId:    aws.String(detectorID),
+			EventTypeName: aws.String(d.Get("event_type_name").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if d.IsNewResource() {
+			input.Tags = getTagsIn(ctx)
+		}
+
+		_, err := conn.PutDetectorWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "putting Fraud Detector Detector (%s): %s", detectorID, err)
+		}
+
+		if d.IsNewResource() {
+			d.SetId(detectorID)
+		}
+	}
+
+	return append(diags, resourceDetectorRead(ctx, d, meta)...)
+}
+
+func resourceDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	detector, err := FindDetectorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Detector (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", detector.Arn)
+	d.Set("description", detector.Description)
+	d.Set("detector_id", detector.DetectorId)
+	d.Set("event_type_name", detector.EventTypeName)
+
+	return diags
+}
+
+func resourceDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	log.Printf("[INFO] Deleting Fraud Detector Detector: %s", d.Id())
+	_, err := conn.DeleteDetectorWithContext(ctx, &frauddetector.DeleteDetectorInput{
+		DetectorId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Detector (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDetectorByID(ctx context.Context, conn *frauddetector.FraudDetector, id string) (*frauddetector.Detector, error) {
+	input := &frauddetector.GetDetectorsInput{
+		DetectorId: aws.String(id),
+	}
+
+	output, err := conn.GetDetectorsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSinglePtrResult(output.Detectors)
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_event_type", name="Event Type")
+// @Tags(identifierAttribute="arn")
+func ResourceEventType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEventTypePut,
+		ReadWithoutTimeout:   resourceEventTypeRead,
+		UpdateWithoutTimeout: resourceEventTypePut,
+		DeleteWithoutTimeout: resourceEventTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"entity_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"event_ingestion": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.EventIngestion_Values(), false),
+			},
+			"event_variables": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEventTypePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	if d.IsNewResource() || d.HasChangesExcept("tags", "tags_all") {
+		name := d.Get("name").(string)
+		input := &frauddetector.PutEventTypeInput{
+			EntityTypes:    flex.ExpandStringSet(d.Get("entity_types").(*schema.Set)),
+			EventVariables: flex.ExpandStringSet(d.Get("event_variables").(*schema.Set)),
+			Name:           aws.String(name),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("event_ingestion"); ok {
+			input.EventIngestion = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("labels"); ok && v.(*schema.Set).Len() > 0 {
+			input.Labels = flex.ExpandStringSet(v.(*schema.Set))
+		}
+
+		if d.IsNewResource() {
+			input.Tags = getTagsIn(ctx)
+		}
+
+		_, err := conn.PutEventTypeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "putting Fraud Detector Event Type (%s): %s", name, err)
+		}
+
+		if d.IsNewResource() {
+			d.SetId(name)
+		}
+	}
+
+	return append(diags, resourceEventTypeRead(ctx, d, meta)...)
+}
+
+func resourceEventTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	eventType, err := FindEventTypeByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Event Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Event Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", eventType.Arn)
+	d.Set("description", eventType.Description)
+	d.Set("entity_types", aws.StringValueSlice(eventType.EntityTypes))
+	d.Set("event_ingestion", eventType.EventIngestion)
+	d.Set("event_variables", aws.StringValueSlice(eventType.EventVariables))
+	d.Set("labels", aws.StringValueSlice(eventType.Labels))
+	d.Set("name", eventType.Name)
+
+	return diags
+}
+
+func resourceEventTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	log.Printf("[INFO] Deleting Fraud Detector Event Type: %s", d.Id())
+	_, err := conn.DeleteEventTypeWithContext(ctx, &frauddetector.DeleteEventTypeInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Event Type (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindEventTypeByName(ctx context.Context, conn *frauddetector.FraudDetector, name string) (*frauddetector.EventType, error) {
+	input := &frauddetector.GetEventTypesInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetEventTypesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSinglePtrResult(output.EventTypes)
+}
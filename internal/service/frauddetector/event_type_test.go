@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorEventType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.EventType
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("event-type/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "entity_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_ingestion", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "event_variables.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEventTypeConfig_eventIngestion(rName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "event_ingestion", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorEventType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.EventType
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_frauddetector_event_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEventTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceEventType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEventTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_event_type" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindEventTypeByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Event Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEventTypeExists(ctx context.Context, n string, v *frauddetector.EventType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		output, err := tffrauddetector.FindEventTypeByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEventTypeConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_variable" "test" {
+  name          = %[2]q
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "unknown"
+  variable_type = "EMAIL_ADDRESS"
+}
+
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+}
+
+resource "aws_frauddetector_label" "test" {
+  name = %[1]q
+}
+`, rName, strings.ReplaceAll(rName, "-", "_"))
+}
+
+func testAccEventTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_variables = [aws_frauddetector_variable.test.name]
+  labels          = [aws_frauddetector_label.test.name]
+}
+`, rName))
+}
+
+func testAccEventTypeConfig_eventIngestion(rName, eventIngestion string) string {
+	return acctest.ConfigCompose(testAccEventTypeConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_ingestion = %[2]q
+  event_variables = [aws_frauddetector_variable.test.name]
+  labels          = [aws_frauddetector_label.test.name]
+}
+`, rName, eventIngestion))
+}
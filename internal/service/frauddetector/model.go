@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_model", name="Model")
+// @Tags(identifierAttribute="arn")
+func ResourceModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceModelCreate,
+		ReadWithoutTimeout:   resourceModelRead,
+		UpdateWithoutTimeout: resourceModelUpdate,
+		DeleteWithoutTimeout: resourceModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"event_type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"model_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNameWithoutHyphens,
+			},
+			"model_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.ModelTypeEnum_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	modelResourceIDPartCount = 2
+)
+
+func resourceModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	modelID, modelType := d.Get("model_id").(string), d.Get("model_type").(string)
+	id, err := flex.FlattenResourceId([]string{modelID, modelType}, modelResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &frauddetector.CreateModelInput{
+		EventTypeName: aws.String(d.Get("event_type_name").(string)),
+		ModelId:       aws.String(modelID),
+		ModelType:     aws.String(modelType),
+		Tags:          getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.CreateModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Fraud Detector Model (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceModelRead(ctx, d, meta)...)
+}
+
+func resourceModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), modelResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	model, err := FindModelByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Model (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", model.Arn)
+	d.Set("description", model.Description)
+	d.Set("event_type_name", model.EventTypeName)
+	d.Set("model_id", model.ModelId)
+	d.Set("model_type", model.ModelType)
+
+	return diags
+}
+
+func resourceModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	if d.HasChange("description") {
+		input := &frauddetector.UpdateModelInput{
+			Description: aws.String(d.Get("description").(string)),
+			ModelId:     aws.String(d.Get("model_id").(string)),
+			ModelType:   aws.String(d.Get("model_type").(string)),
+		}
+
+		_, err := conn.UpdateModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Model (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceModelRead(ctx, d, meta)...)
+}
+
+func resourceModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	log.Printf("[INFO] Deleting Fraud Detector Model: %s", d.Id())
+	_, err := conn.DeleteModelWithContext(ctx, &frauddetector.DeleteModelInput{
+		ModelId:   aws.String(d.Get("model_id").(string)),
+		ModelType: aws.String(d.Get("model_type").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Model (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindModelByTwoPartKey(ctx context.Context, conn *frauddetector.FraudDetector, modelID, modelType string) (*frauddetector.Model, error) {
+	input := &frauddetector.GetModelsInput{
+		ModelId:   aws.String(modelID),
+		ModelType: aws.String(modelType),
+	}
+
+	output, err := conn.GetModelsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSinglePtrResult(output.Models)
+}
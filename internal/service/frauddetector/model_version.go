@@ -0,0 +1,580 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_model_version", name="Model Version")
+// @Tags(identifierAttribute="arn")
+func ResourceModelVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceModelVersionCreate,
+		ReadWithoutTimeout:   resourceModelVersionRead,
+		UpdateWithoutTimeout: resourceModelVersionUpdate,
+		DeleteWithoutTimeout: resourceModelVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(8 * time.Hour),
+			Update: schema.DefaultTimeout(1 * time.Hour),
+			Delete: schema.DefaultTimeout(1 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_events_detail": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_access_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"data_location": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"ingested_events_detail": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ingested_events_time_window": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+									"start_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"model_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validNameWithoutHyphens,
+			},
+			"model_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.ModelTypeEnum_Values(), false),
+			},
+			"model_version_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      modelVersionStatusActive,
+				ValidateFunc: validation.StringInSlice([]string{modelVersionStatusActive, modelVersionStatusInactive}, false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"training_data_schema": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label_schema": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"label_mapper": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event_labels": {
+													Type:     schema.TypeSet,
+													Required: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"model_label": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"unlabeled_events_treatment": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(frauddetector.UnlabeledEventsTreatment_Values(), false),
+									},
+								},
+							},
+						},
+						"model_variables": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"training_data_source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(frauddetector.TrainingDataSourceEnum_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	modelVersionResourceIDPartCount = 3
+)
+
+func resourceModelVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	modelID, modelType := d.Get("model_id").(string), d.Get("model_type").(string)
+	input := &frauddetector.CreateModelVersionInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		Tags:               getTagsIn(ctx),
+		TrainingDataSchema: expandTrainingDataSchema(d.Get("training_data_schema").([]interface{})),
+		TrainingDataSource: aws.String(d.Get("training_data_source").(string)),
+	}
+
+	if v, ok := d.GetOk("external_events_detail"); ok {
+		input.ExternalEventsDetail = expandExternalEventsDetail(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("ingested_events_detail"); ok {
+		input.IngestedEventsDetail = expandIngestedEventsDetail(v.([]interface{}))
+	}
+
+	output, err := conn.CreateModelVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Fraud Detector Model Version (%s/%s): %s", modelID, modelType, err)
+	}
+
+	modelVersionNumber := aws.StringValue(output.ModelVersionNumber)
+	id, err := flex.FlattenResourceId([]string{modelID, modelType, modelVersionNumber}, modelVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if _, err := waitModelVersionTrained(ctx, conn, modelID, modelType, modelVersionNumber, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Fraud Detector Model Version (%s) training: %s", d.Id(), err)
+	}
+
+	if d.Get("status").(string) == modelVersionStatusActive {
+		if err := updateModelVersionStatus(ctx, conn, modelID, modelType, modelVersionNumber, modelVersionStatusActive, timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "activating Fraud Detector Model Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceModelVersionRead(ctx, d, meta)...)
+}
+
+func resourceModelVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), modelVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindModelVersionByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Model Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Model Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("external_events_detail", flattenExternalEventsDetail(output.ExternalEventsDetail)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting external_events_detail: %s", err)
+	}
+	if err := d.Set("ingested_events_detail", flattenIngestedEventsDetail(output.IngestedEventsDetail)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ingested_events_detail: %s", err)
+	}
+	d.Set("model_id", output.ModelId)
+	d.Set("model_type", output.ModelType)
+	d.Set("model_version_number", output.ModelVersionNumber)
+	// A trained model version that has never been activated is reported as inactive.
+	if status := aws.StringValue(output.Status); status == modelVersionStatusActive {
+		d.Set("status", status)
+	} else {
+		d.Set("status", modelVersionStatusInactive)
+	}
+	if err := d.Set("training_data_schema", flattenTrainingDataSchema(output.TrainingDataSchema)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting training_data_schema: %s", err)
+	}
+	d.Set("training_data_source", output.TrainingDataSource)
+
+	return diags
+}
+
+func resourceModelVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	if d.HasChange("status") {
+		modelID, modelType, modelVersionNumber := d.Get("model_id").(string), d.Get("model_type").(string), d.Get("model_version_number").(string)
+
+		if err := updateModelVersionStatus(ctx, conn, modelID, modelType, modelVersionNumber, d.Get("status").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Model Version (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceModelVersionRead(ctx, d, meta)...)
+}
+
+func resourceModelVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	modelID, modelType, modelVersionNumber := d.Get("model_id").(string), d.Get("model_type").(string), d.Get("model_version_number").(string)
+
+	// An active model version must be deactivated before it can be deleted.
+	if d.Get("status").(string) == modelVersionStatusActive {
+		err := updateModelVersionStatus(ctx, conn, modelID, modelType, modelVersionNumber, modelVersionStatusInactive, d.Timeout(schema.TimeoutDelete))
+
+		if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deactivating Fraud Detector Model Version (%s): %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting Fraud Detector Model Version: %s", d.Id())
+	_, err := conn.DeleteModelVersionWithContext(ctx, &frauddetector.DeleteModelVersionInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		ModelVersionNumber: aws.String(modelVersionNumber),
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Model Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateModelVersionStatus(ctx context.Context, conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber, status string, timeout time.Duration) error {
+	input := &frauddetector.UpdateModelVersionStatusInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		ModelVersionNumber: aws.String(modelVersionNumber),
+		Status:             aws.String(status),
+	}
+
+	if _, err := conn.UpdateModelVersionStatusWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	var err error
+	if status == modelVersionStatusActive {
+		_, err = waitModelVersionActive(ctx, conn, modelID, modelType, modelVersionNumber, timeout)
+	} else {
+		_, err = waitModelVersionInactive(ctx, conn, modelID, modelType, modelVersionNumber, timeout)
+	}
+
+	return err
+}
+
+func FindModelVersionByThreePartKey(ctx context.Context, conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string) (*frauddetector.GetModelVersionOutput, error) {
+	input := &frauddetector.GetModelVersionInput{
+		ModelId:            aws.String(modelID),
+		ModelType:          aws.String(modelType),
+		ModelVersionNumber: aws.String(modelVersionNumber),
+	}
+
+	output, err := conn.GetModelVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusModelVersion(ctx context.Context, conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindModelVersionByThreePartKey(ctx, conn, modelID, modelType, modelVersionNumber)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitModelVersionTrained(ctx context.Context, conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string, timeout time.Duration) (*frauddetector.GetModelVersionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{modelVersionStatusTrainingInProgress},
+		Target:     []string{modelVersionStatusTrainingComplete},
+		Refresh:    statusModelVersion(ctx, conn, modelID, modelType, modelVersionNumber),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*frauddetector.GetModelVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelVersionActive(ctx context.Context, conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string, timeout time.Duration) (*frauddetector.GetModelVersionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{modelVersionStatusActivateRequested, modelVersionStatusActivateInProgress},
+		Target:  []string{modelVersionStatusActive},
+		Refresh: statusModelVersion(ctx, conn, modelID, modelType, modelVersionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*frauddetector.GetModelVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelVersionInactive(ctx context.Context, conn *frauddetector.FraudDetector, modelID, modelType, modelVersionNumber string, timeout time.Duration) (*frauddetector.GetModelVersionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{modelVersionStatusInactivateRequested, modelVersionStatusInactivateInProgress},
+		Target:  []string{modelVersionStatusInactive},
+		Refresh: statusModelVersion(ctx, conn, modelID, modelType, modelVersionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*frauddetector.GetModelVersionOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandTrainingDataSchema(tfList []interface{}) *frauddetector.TrainingDataSchema {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &frauddetector.TrainingDataSchema{
+		ModelVariables: flex.ExpandStringList(tfMap["model_variables"].([]interface{})),
+	}
+
+	if v, ok := tfMap["label_schema"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LabelSchema = expandLabelSchema(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandLabelSchema(tfMap map[string]interface{}) *frauddetector.LabelSchema {
+	apiObject := &frauddetector.LabelSchema{}
+
+	if v, ok := tfMap["label_mapper"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LabelMapper = make(map[string][]*string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			apiObject.LabelMapper[tfMap["model_label"].(string)] = flex.ExpandStringSet(tfMap["event_labels"].(*schema.Set))
+		}
+	}
+
+	if v, ok := tfMap["unlabeled_events_treatment"].(string); ok && v != "" {
+		apiObject.UnlabeledEventsTreatment = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandExternalEventsDetail(tfList []interface{}) *frauddetector.ExternalEventsDetail {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &frauddetector.ExternalEventsDetail{
+		DataAccessRoleArn: aws.String(tfMap["data_access_role_arn"].(string)),
+		DataLocation:      aws.String(tfMap["data_location"].(string)),
+	}
+}
+
+func expandIngestedEventsDetail(tfList []interface{}) *frauddetector.IngestedEventsDetail {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &frauddetector.IngestedEventsDetail{}
+
+	if v, ok := tfMap["ingested_events_time_window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.IngestedEventsTimeWindow = &frauddetector.IngestedEventsTimeWindow{
+			EndTime:   aws.String(tfMap["end_time"].(string)),
+			StartTime: aws.String(tfMap["start_time"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTrainingDataSchema(apiObject *frauddetector.TrainingDataSchema) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"model_variables": aws.StringValueSlice(apiObject.ModelVariables),
+	}
+
+	if v := apiObject.LabelSchema; v != nil {
+		var labelMapper []interface{}
+
+		for k, v := range v.LabelMapper {
+			labelMapper = append(labelMapper, map[string]interface{}{
+				"event_labels": aws.StringValueSlice(v),
+				"model_label":  k,
+			})
+		}
+
+		tfMap["label_schema"] = []interface{}{map[string]interface{}{
+			"label_mapper":               labelMapper,
+			"unlabeled_events_treatment": aws.StringValue(v.UnlabeledEventsTreatment),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenExternalEventsDetail(apiObject *frauddetector.ExternalEventsDetail) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"data_access_role_arn": aws.StringValue(apiObject.DataAccessRoleArn),
+		"data_location":        aws.StringValue(apiObject.DataLocation),
+	}}
+}
+
+func flattenIngestedEventsDetail(apiObject *frauddetector.IngestedEventsDetail) []interface{} {
+	if apiObject == nil || apiObject.IngestedEventsTimeWindow == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"ingested_events_time_window": []interface{}{map[string]interface{}{
+			"end_time":   aws.StringValue(apiObject.IngestedEventsTimeWindow.EndTime),
+			"start_time": aws.StringValue(apiObject.IngestedEventsTimeWindow.StartTime),
+		}},
+	}}
+}
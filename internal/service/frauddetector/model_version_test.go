@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorModelVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v frauddetector.GetModelVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_frauddetector_model_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelVersionConfig_status(rName, "ACTIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("model-version/ONLINE_FRAUD_INSIGHTS/%s/1.0", strings.ReplaceAll(rName, "-", "_"))),
+					resource.TestCheckResourceAttr(resourceName, "external_events_detail.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "external_events_detail.0.data_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "model_id", "aws_frauddetector_model.test", "model_id"),
+					resource.TestCheckResourceAttr(resourceName, "model_type", "ONLINE_FRAUD_INSIGHTS"),
+					resource.TestCheckResourceAttr(resourceName, "model_version_number", "1.0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_schema.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_schema.0.label_schema.0.label_mapper.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_schema.0.model_variables.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "training_data_source", "EXTERNAL_EVENTS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccModelVersionConfig_status(rName, "INACTIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+				),
+			},
+			{
+				Config: testAccModelVersionConfig_status(rName, "ACTIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorModelVersion_inactive(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v frauddetector.GetModelVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_frauddetector_model_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelVersionConfig_status(rName, "INACTIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "INACTIVE"),
+				),
+			},
+			{
+				Config: testAccModelVersionConfig_status(rName, "ACTIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorModelVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v frauddetector.GetModelVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_frauddetector_model_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelVersionConfig_status(rName, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceModelVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckModelVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_model_version" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tffrauddetector.FindModelVersionByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Model Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckModelVersionExists(ctx context.Context, n string, v *frauddetector.GetModelVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		output, err := tffrauddetector.FindModelVersionByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccModelVersionTrainingData returns a synthetic CSV training dataset large enough
+// for an Online Fraud Insights model: at least 10,000 events, of which at least 400 are fraudulent.
+func testAccModelVersionTrainingData(emailVariable, ipVariable, fraudLabel, legitLabel string) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s,%s,EVENT_TIMESTAMP,EVENT_LABEL\n", emailVariable, ipVariable)
+
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 10000; i++ {
+		email, ip, label := fmt.Sprintf("user%d@example.com", i), fmt.Sprintf("10.0.%d.%d", (i/250)%250, i%250), legitLabel
+		if i%20 == 0 {
+			email, ip, label = fmt.Sprintf("x%d@example.net", i), fmt.Sprintf("192.168.%d.%d", (i/250)%250, i%250), fraudLabel
+		}
+		fmt.Fprintf(&sb, "%s,%s,%s,%s\n", email, ip, start.Add(time.Duration(i)*time.Hour).Format(time.RFC3339), label)
+	}
+
+	return sb.String()
+}
+
+func testAccModelVersionConfig_base(rName string) string {
+	name := strings.ReplaceAll(rName, "-", "_")
+	emailVariable, ipVariable := name+"_email", name+"_ip"
+	fraudLabel, legitLabel := rName+"-fraud", rName+"-legit"
+
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "training.csv"
+  content = %[7]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "frauddetector.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_frauddetector_variable" "email" {
+  name          = %[3]q
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "unknown"
+  variable_type = "EMAIL_ADDRESS"
+}
+
+resource "aws_frauddetector_variable" "ip" {
+  name          = %[4]q
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = "unknown"
+  variable_type = "IP_ADDRESS"
+}
+
+resource "aws_frauddetector_entity_type" "test" {
+  name = %[1]q
+}
+
+resource "aws_frauddetector_label" "fraud" {
+  name = %[5]q
+}
+
+resource "aws_frauddetector_label" "legit" {
+  name = %[6]q
+}
+
+resource "aws_frauddetector_event_type" "test" {
+  name            = %[1]q
+  entity_types    = [aws_frauddetector_entity_type.test.name]
+  event_variables = [aws_frauddetector_variable.email.name, aws_frauddetector_variable.ip.name]
+  labels          = [aws_frauddetector_label.fraud.name, aws_frauddetector_label.legit.name]
+}
+
+resource "aws_frauddetector_model" "test" {
+  model_id        = %[2]q
+  model_type      = "ONLINE_FRAUD_INSIGHTS"
+  event_type_name = aws_frauddetector_event_type.test.name
+}
+`, rName, name, emailVariable, ipVariable, fraudLabel, legitLabel, testAccModelVersionTrainingData(emailVariable, ipVariable, fraudLabel, legitLabel))
+}
+
+func testAccModelVersionConfig_status(rName, status string) string {
+	return acctest.ConfigCompose(testAccModelVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_frauddetector_model_version" "test" {
+  model_id             = aws_frauddetector_model.test.model_id
+  model_type           = aws_frauddetector_model.test.model_type
+  status               = %[1]q
+  training_data_source = "EXTERNAL_EVENTS"
+
+  training_data_schema {
+    model_variables = [aws_frauddetector_variable.email.name, aws_frauddetector_variable.ip.name]
+
+    label_schema {
+      label_mapper {
+        model_label  = "FRAUD"
+        event_labels = [aws_frauddetector_label.fraud.name]
+      }
+
+      label_mapper {
+        model_label  = "LEGIT"
+        event_labels = [aws_frauddetector_label.legit.name]
+      }
+    }
+  }
+
+  external_events_detail {
+    data_access_role_arn = aws_iam_role.test.arn
+    data_location        = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, status))
+}
@@ -0,0 +1,359 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_frauddetector_rule", name="Rule")
+// @Tags(identifierAttribute="arn")
+func ResourceRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuleCreate,
+		ReadWithoutTimeout:   resourceRuleRead,
+		UpdateWithoutTimeout: resourceRuleUpdate,
+		DeleteWithoutTimeout: resourceRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      frauddetector.LanguageDetectorpl,
+				ValidateFunc: validation.StringInSlice(frauddetector.Language_Values(), false),
+			},
+			"outcomes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"rule_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			updateComputedAttributesOnRuleVersionChange,
+		),
+	}
+}
+
+func updateComputedAttributesOnRuleVersionChange(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChanges("expression", "language", "outcomes") {
+		d.SetNewComputed("arn")
+		d.SetNewComputed("rule_version")
+	}
+
+	return nil
+}
+
+const (
+	ruleResourceIDPartCount = 2
+)
+
+func resourceRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	detectorID, ruleID := d.Get("detector_id").(string), d.Get("rule_id").(string)
+	id, err := flex.FlattenResourceId([]string{detectorID, ruleID}, ruleResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &frauddetector.CreateRuleInput{
+		DetectorId: aws.String(detectorID),
+		Expression: aws.String(d.Get("expression").(string)),
+		Language:   aws.String(d.Get("language").(string)),
+		Outcomes:   flex.ExpandStringList(d.Get("outcomes").([]interface{})),
+		RuleId:     aws.String(ruleID),
+		Tags:       getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateRuleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Fraud Detector Rule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("rule_version", output.Rule.RuleVersion)
+
+	return append(diags, resourceRuleRead(ctx, d, meta)...)
+}
+
+func resourceRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ruleResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	detectorID, ruleID := parts[0], parts[1]
+
+	// An imported rule has no version in state; use the latest version.
+	var rule *frauddetector.RuleDetail
+	if v, ok := d.GetOk("rule_version"); ok {
+		rule, err = FindRuleByThreePartKey(ctx, conn, detectorID, ruleID, v.(string))
+	} else {
+		rule, err = FindLatestRuleByTwoPartKey(ctx, conn, detectorID, ruleID)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Fraud Detector Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Rule (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", rule.Arn)
+	d.Set("description", rule.Description)
+	d.Set("detector_id", rule.DetectorId)
+	d.Set("expression", rule.Expression)
+	d.Set("language", rule.Language)
+	d.Set("outcomes", aws.StringValueSlice(rule.Outcomes))
+	d.Set("rule_id", rule.RuleId)
+	d.Set("rule_version", rule.RuleVersion)
+
+	return diags
+}
+
+func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	rule := &frauddetector.Rule{
+		DetectorId:  aws.String(d.Get("detector_id").(string)),
+		RuleId:      aws.String(d.Get("rule_id").(string)),
+		RuleVersion: aws.String(d.Get("rule_version").(string)),
+	}
+
+	// A change to the rule logic creates a new, separately tagged, rule version.
+	// Earlier versions are kept so that detector versions that reference them continue to work.
+	if d.HasChanges("expression", "language", "outcomes") {
+		input := &frauddetector.UpdateRuleVersionInput{
+			Expression: aws.String(d.Get("expression").(string)),
+			Language:   aws.String(d.Get("language").(string)),
+			Outcomes:   flex.ExpandStringList(d.Get("outcomes").([]interface{})),
+			Rule:       rule,
+			Tags:       getTagsIn(ctx),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		output, err := conn.UpdateRuleVersionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Rule (%s) version: %s", d.Id(), err)
+		}
+
+		d.Set("rule_version", output.Rule.RuleVersion)
+	} else if d.HasChange("description") {
+		input := &frauddetector.UpdateRuleMetadataInput{
+			Description: aws.String(d.Get("description").(string)),
+			Rule:        rule,
+		}
+
+		_, err := conn.UpdateRuleMetadataWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Fraud Detector Rule (%s) metadata: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRuleRead(ctx, d, meta)...)
+}
+
+func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).FraudDetectorConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ruleResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	rules, err := findRulesByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Fraud Detector Rule (%s): %s", d.Id(), err)
+	}
+
+	for _, v := range rules {
+		log.Printf("[INFO] Deleting Fraud Detector Rule: %s, version %s", d.Id(), aws.StringValue(v.RuleVersion))
+		_, err := conn.DeleteRuleWithContext(ctx, &frauddetector.DeleteRuleInput{
+			Rule: &frauddetector.Rule{
+				DetectorId:  v.DetectorId,
+				RuleId:      v.RuleId,
+				RuleVersion: v.RuleVersion,
+			},
+		})
+
+		if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Fraud Detector Rule (%s) version %s: %s", d.Id(), aws.StringValue(v.RuleVersion), err)
+		}
+	}
+
+	return diags
+}
+
+func findRules(ctx context.Context, conn *frauddetector.FraudDetector, input *frauddetector.GetRulesInput) ([]*frauddetector.RuleDetail, error) {
+	var output []*frauddetector.RuleDetail
+
+	err := conn.GetRulesPagesWithContext(ctx, input, func(page *frauddetector.GetRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RuleDetails {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, frauddetector.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findRulesByTwoPartKey(ctx context.Context, conn *frauddetector.FraudDetector, detectorID, ruleID string) ([]*frauddetector.RuleDetail, error) {
+	input := &frauddetector.GetRulesInput{
+		DetectorId: aws.String(detectorID),
+		RuleId:     aws.String(ruleID),
+	}
+
+	return findRules(ctx, conn, input)
+}
+
+func FindLatestRuleByTwoPartKey(ctx context.Context, conn *frauddetector.FraudDetector, detectorID, ruleID string) (*frauddetector.RuleDetail, error) {
+	rules, err := findRulesByTwoPartKey(ctx, conn, detectorID, ruleID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *frauddetector.RuleDetail
+	var latestVersion int
+
+	for _, v := range rules {
+		version, err := strconv.Atoi(aws.StringValue(v.RuleVersion))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if latest == nil || version > latestVersion {
+			latest, latestVersion = v, version
+		}
+	}
+
+	return latest, nil
+}
+
+func FindRuleByThreePartKey(ctx context.Context, conn *frauddetector.FraudDetector, detectorID, ruleID, ruleVersion string) (*frauddetector.RuleDetail, error) {
+	input := &frauddetector.GetRulesInput{
+		DetectorId:  aws.String(detectorID),
+		RuleId:      aws.String(ruleID),
+		RuleVersion: aws.String(ruleVersion),
+	}
+
+	output, err := findRules(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
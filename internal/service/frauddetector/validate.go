@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector
+
+import (
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexache.MustCompile(`^[0-9a-z_-]+$`), "must contain only lowercase alphanumeric characters, underscores and hyphens"),
+)
+
+// validNameWithoutHyphens validates names that are referenced from rule expressions and model training data.
+var validNameWithoutHyphens = validation.All(
+	validation.StringLenBetween(1, 64),
+	validation.StringMatch(regexache.MustCompile(`^[0-9a-z_]+$`), "must contain only lowercase alphanumeric characters and underscores"),
+)
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package frauddetector_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/frauddetector"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffrauddetector "github.com/hashicorp/terraform-provider-aws/internal/service/frauddetector"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccFraudDetectorVariable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.Variable
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_variable.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVariableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariableConfig_basic(rName, "unknown"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "frauddetector", fmt.Sprintf("variable/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "data_source", "EVENT"),
+					resource.TestCheckResourceAttr(resourceName, "data_type", "STRING"),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "unknown"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "variable_type", "EMAIL_ADDRESS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVariableConfig_basic(rName, "none"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_value", "none"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFraudDetectorVariable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v frauddetector.Variable
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+	resourceName := "aws_frauddetector_variable.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, frauddetector.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, frauddetector.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVariableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariableConfig_basic(rName, "unknown"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVariableExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tffrauddetector.ResourceVariable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVariableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_frauddetector_variable" {
+				continue
+			}
+
+			_, err := tffrauddetector.FindVariableByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Fraud Detector Variable %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVariableExists(ctx context.Context, n string, v *frauddetector.Variable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FraudDetectorConn(ctx)
+
+		output, err := tffrauddetector.FindVariableByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVariableConfig_basic(rName, defaultValue string) string {
+	return fmt.Sprintf(`
+resource "aws_frauddetector_variable" "test" {
+  name          = %[1]q
+  data_source   = "EVENT"
+  data_type     = "STRING"
+  default_value = %[2]q
+  variable_type = "EMAIL_ADDRESS"
+}
+`, rName, defaultValue)
+}
@@ -56,6 +56,11 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"lambda_code": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -126,7 +131,7 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		return create.DiagError(names.Inspector2, create.ErrActionUpdating, ResNameOrganizationConfiguration, d.Id(), err)
 	}
 
-	if err := waitOrganizationConfigurationUpdated(ctx, conn, d.Get("auto_enable.0.ec2").(bool), d.Get("auto_enable.0.ecr").(bool), d.Get("auto_enable.0.lambda").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := waitOrganizationConfigurationUpdated(ctx, conn, d.Get("auto_enable.0.ec2").(bool), d.Get("auto_enable.0.ecr").(bool), d.Get("auto_enable.0.lambda").(bool), d.Get("auto_enable.0.lambda_code").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return create.DiagError(names.Inspector2, create.ErrActionWaitingForUpdate, ResNameOrganizationConfiguration, d.Id(), err)
 	}
 
@@ -141,9 +146,10 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 
 	in := &inspector2.UpdateOrganizationConfigurationInput{
 		AutoEnable: &types.AutoEnable{
-			Ec2:    aws.Bool(false),
-			Ecr:    aws.Bool(false),
-			Lambda: aws.Bool(false),
+			Ec2:        aws.Bool(false),
+			Ecr:        aws.Bool(false),
+			Lambda:     aws.Bool(false),
+			LambdaCode: aws.Bool(false),
 		},
 	}
 
@@ -153,31 +159,26 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 		return create.DiagError(names.Inspector2, create.ErrActionUpdating, ResNameOrganizationConfiguration, d.Id(), err)
 	}
 
-	if err := waitOrganizationConfigurationUpdated(ctx, conn, false, false, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if err := waitOrganizationConfigurationUpdated(ctx, conn, false, false, false, false, d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return create.DiagError(names.Inspector2, create.ErrActionWaitingForUpdate, ResNameOrganizationConfiguration, d.Id(), err)
 	}
 
 	return nil
 }
 
-func waitOrganizationConfigurationUpdated(ctx context.Context, conn *inspector2.Client, ec2, ecr, lambda bool, timeout time.Duration) error {
-	needle := fmt.Sprintf("%t:%t:%t", ec2, ecr, lambda)
-
-	all := []string{
-		fmt.Sprintf("%t:%t:%t", false, false, false),
-		fmt.Sprintf("%t:%t:%t", false, true, false),
-		fmt.Sprintf("%t:%t:%t", false, false, true),
-		fmt.Sprintf("%t:%t:%t", false, true, true),
-		fmt.Sprintf("%t:%t:%t", true, false, false),
-		fmt.Sprintf("%t:%t:%t", true, false, true),
-		fmt.Sprintf("%t:%t:%t", true, true, false),
-		fmt.Sprintf("%t:%t:%t", true, true, true),
-	}
-
-	for i, v := range all {
-		if v == needle {
-			all = append(all[:i], all[i+1:]...)
-			break
+func waitOrganizationConfigurationUpdated(ctx context.Context, conn *inspector2.Client, ec2, ecr, lambda, lambdaCode bool, timeout time.Duration) error {
+	needle := fmt.Sprintf("%t:%t:%t:%t", ec2, ecr, lambda, lambdaCode)
+
+	var all []string
+	for _, ec2 := range []bool{false, true} {
+		for _, ecr := range []bool{false, true} {
+			for _, lambda := range []bool{false, true} {
+				for _, lambdaCode := range []bool{false, true} {
+					if v := fmt.Sprintf("%t:%t:%t:%t", ec2, ecr, lambda, lambdaCode); v != needle {
+						all = append(all, v)
+					}
+				}
+			}
 		}
 	}
 
@@ -207,7 +208,7 @@ func statusOrganizationConfiguration(ctx context.Context, conn *inspector2.Clien
 			return nil, "", err
 		}
 
-		return out, fmt.Sprintf("%t:%t:%t", aws.ToBool(out.AutoEnable.Ec2), aws.ToBool(out.AutoEnable.Ecr), aws.ToBool(out.AutoEnable.Lambda)), nil
+		return out, fmt.Sprintf("%t:%t:%t:%t", aws.ToBool(out.AutoEnable.Ec2), aws.ToBool(out.AutoEnable.Ecr), aws.ToBool(out.AutoEnable.Lambda), aws.ToBool(out.AutoEnable.LambdaCode)), nil
 	}
 }
 
@@ -230,6 +231,10 @@ func flattenAutoEnable(apiObject *types.AutoEnable) map[string]interface{} {
 		m["lambda"] = aws.ToBool(v)
 	}
 
+	if v := apiObject.LambdaCode; v != nil {
+		m["lambda_code"] = aws.ToBool(v)
+	}
+
 	return m
 }
 
@@ -252,5 +257,9 @@ func expandAutoEnable(tfMap map[string]interface{}) *types.AutoEnable {
 		a.Lambda = aws.Bool(v)
 	}
 
+	if v, ok := tfMap["lambda_code"].(bool); ok {
+		a.LambdaCode = aws.Bool(v)
+	}
+
 	return a
 }
@@ -125,6 +125,36 @@ func testAccOrganizationConfiguration_lambda(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "false"),
 					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "false"),
 					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda_code", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfiguration_lambdaCode(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_inspector2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			testAccPreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.Inspector2EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationConfigurationConfig_lambdaCode(false, false, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ec2", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.ecr", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable.0.lambda_code", "true"),
 				),
 			},
 		},
@@ -164,7 +194,7 @@ func testAccCheckOrganizationConfigurationDestroy(ctx context.Context) resource.
 				return create.Error(names.Inspector2, create.ErrActionCheckingDestroyed, tfinspector2.ResNameOrganizationConfiguration, rs.Primary.ID, err)
 			}
 
-			if out != nil && out.AutoEnable != nil && !aws.ToBool(out.AutoEnable.Ec2) && !aws.ToBool(out.AutoEnable.Ecr) && !aws.ToBool(out.AutoEnable.Lambda) {
+			if out != nil && out.AutoEnable != nil && !aws.ToBool(out.AutoEnable.Ec2) && !aws.ToBool(out.AutoEnable.Ecr) && !aws.ToBool(out.AutoEnable.Lambda) && !aws.ToBool(out.AutoEnable.LambdaCode) {
 				if enabledDelAdAcct {
 					if err := testDisableDelegatedAdminAccount(ctx, conn, acctest.AccountID()); err != nil {
 						return err
@@ -275,3 +305,24 @@ resource "aws_inspector2_organization_configuration" "test" {
 }
 `, ec2, ecr, lambda)
 }
+
+func testAccOrganizationConfigurationConfig_lambdaCode(ec2, ecr, lambda, lambdaCode bool) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_delegated_admin_account" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_inspector2_organization_configuration" "test" {
+  auto_enable {
+    ec2         = %[1]t
+    ecr         = %[2]t
+    lambda      = %[3]t
+    lambda_code = %[4]t
+  }
+
+  depends_on = [aws_inspector2_delegated_admin_account.test]
+}
+`, ec2, ecr, lambda, lambdaCode)
+}
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_msk_client_vpc_connection", name="Client VPC Connection")
+func ResourceClientVPCConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClientVPCConnectionCreate,
+		ReadWithoutTimeout:   resourceClientVPCConnectionRead,
+		DeleteWithoutTimeout: resourceClientVPCConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authentication": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_connection_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceClientVPCConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KafkaClient(ctx)
+
+	clusterARN := d.Get("cluster_arn").(string)
+	vpcConnectionARN := d.Get("vpc_connection_arn").(string)
+	id := ClientVPCConnectionCreateResourceID(clusterARN, vpcConnectionARN)
+
+	// The connection is created by the client account. MSK has no API to accept it; access is granted
+	// by the cluster policy. The cluster owner can only wait for it to become available.
+	if _, err := waitClientVPCConnectionAvailable(ctx, conn, clusterARN, vpcConnectionARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MSK Client VPC Connection (%s) create: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceClientVPCConnectionRead(ctx, d, meta)...)
+}
+
+func resourceClientVPCConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KafkaClient(ctx)
+
+	clusterARN, vpcConnectionARN, err := ClientVPCConnectionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := FindClientVPCConnectionByTwoPartKey(ctx, conn, clusterARN, vpcConnectionARN)
+
+	if err == nil && clientVPCConnectionIsGone(out.State) {
+		err = &retry.NotFoundError{}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK Client VPC Connection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MSK Client VPC Connection (%s): %s", d.Id(), err)
+	}
+
+	d.Set("authentication", out.Authentication)
+	d.Set("cluster_arn", clusterARN)
+	d.Set("creation_time", aws.ToTime(out.CreationTime).Format(time.RFC3339))
+	d.Set("owner", out.Owner)
+	d.Set("state", out.State)
+	d.Set("vpc_connection_arn", out.VpcConnectionArn)
+
+	return diags
+}
+
+func resourceClientVPCConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KafkaClient(ctx)
+
+	clusterARN, vpcConnectionARN, err := ClientVPCConnectionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Rejecting MSK Client VPC Connection: %s", d.Id())
+	_, err = conn.RejectClientVpcConnection(ctx, &kafka.RejectClientVpcConnectionInput{
+		ClusterArn:       aws.String(clusterARN),
+		VpcConnectionArn: aws.String(vpcConnectionARN),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "rejecting MSK Client VPC Connection (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitClientVPCConnectionRejected(ctx, conn, clusterARN, vpcConnectionARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MSK Client VPC Connection (%s) reject: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const clientVPCConnectionResourceIDSeparator = ","
+
+func ClientVPCConnectionCreateResourceID(clusterARN, vpcConnectionARN string) string {
+	parts := []string{clusterARN, vpcConnectionARN}
+	id := strings.Join(parts, clientVPCConnectionResourceIDSeparator)
+
+	return id
+}
+
+func ClientVPCConnectionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, clientVPCConnectionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CLUSTER-ARN%[2]sVPC-CONNECTION-ARN", id, clientVPCConnectionResourceIDSeparator)
+}
+
+func clientVPCConnectionIsGone(state types.VpcConnectionState) bool {
+	switch state {
+	case types.VpcConnectionStateRejected, types.VpcConnectionStateDeleting:
+		return true
+	default:
+		return false
+	}
+}
+
+func FindClientVPCConnectionByTwoPartKey(ctx context.Context, conn *kafka.Client, clusterARN, vpcConnectionARN string) (*types.ClientVpcConnection, error) {
+	in := &kafka.ListClientVpcConnectionsInput{
+		ClusterArn: aws.String(clusterARN),
+	}
+
+	pages := kafka.NewListClientVpcConnectionsPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ClientVpcConnections {
+			if aws.ToString(v.VpcConnectionArn) == vpcConnectionARN {
+				v := v
+
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func statusClientVPCConnection(ctx context.Context, conn *kafka.Client, clusterARN, vpcConnectionARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindClientVPCConnectionByTwoPartKey(ctx, conn, clusterARN, vpcConnectionARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.State), nil
+	}
+}
+
+func waitClientVPCConnectionAvailable(ctx context.Context, conn *kafka.Client, clusterARN, vpcConnectionARN string, timeout time.Duration) (*types.ClientVpcConnection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.VpcConnectionStateCreating),
+		Target:                    enum.Slice(types.VpcConnectionStateAvailable),
+		Refresh:                   statusClientVPCConnection(ctx, conn, clusterARN, vpcConnectionARN),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.ClientVpcConnection); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+// waitClientVPCConnectionRejected waits for the connection to be rejected.
+// A connection that's being deleted, or is already gone, no longer needs rejecting.
+func waitClientVPCConnectionRejected(ctx context.Context, conn *kafka.Client, clusterARN, vpcConnectionARN string, timeout time.Duration) (*types.ClientVpcConnection, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.VpcConnectionStateAvailable, types.VpcConnectionStateRejecting),
+		Target:  []string{},
+		Refresh: func() (interface{}, string, error) {
+			out, state, err := statusClientVPCConnection(ctx, conn, clusterARN, vpcConnectionARN)()
+
+			if out != nil && clientVPCConnectionIsGone(types.VpcConnectionState(state)) {
+				return nil, "", nil
+			}
+
+			return out, state, err
+		},
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.ClientVpcConnection); ok {
+		return out, err
+	}
+
+	return nil, err
+}
@@ -54,6 +54,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceClientVPCConnection,
+			TypeName: "aws_msk_client_vpc_connection",
+			Name:     "Client VPC Connection",
+		},
 		{
 			Factory:  ResourceCluster,
 			TypeName: "aws_msk_cluster",
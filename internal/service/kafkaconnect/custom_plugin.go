@@ -6,10 +6,15 @@ package kafkaconnect
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,6 +40,8 @@ func ResourceCustomPlugin() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffCustomPluginContent,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -51,6 +58,22 @@ func ResourceCustomPlugin() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"file_description": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_md5": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"file_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"latest_revision": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -158,6 +181,13 @@ func resourceCustomPluginRead(ctx context.Context, d *schema.ResourceData, meta
 
 	if plugin.LatestRevision != nil {
 		d.Set("content_type", plugin.LatestRevision.ContentType)
+		if plugin.LatestRevision.FileDescription != nil {
+			if err := d.Set("file_description", []interface{}{flattenCustomPluginFileDescription(plugin.LatestRevision.FileDescription)}); err != nil {
+				return diag.Errorf("setting file_description: %s", err)
+			}
+		} else {
+			d.Set("file_description", nil)
+		}
 		d.Set("latest_revision", plugin.LatestRevision.Revision)
 		if plugin.LatestRevision.Location != nil {
 			if err := d.Set("location", []interface{}{flattenCustomPluginLocationDescription(plugin.LatestRevision.Location)}); err != nil {
@@ -168,6 +198,7 @@ func resourceCustomPluginRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	} else {
 		d.Set("content_type", nil)
+		d.Set("file_description", nil)
 		d.Set("latest_revision", nil)
 		d.Set("location", nil)
 	}
@@ -200,6 +231,87 @@ func resourceCustomPluginDelete(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// customizeDiffCustomPluginContent forces replacement of a custom plugin whose
+// unversioned S3 object has been overwritten since the plugin was created.
+func customizeDiffCustomPluginContent(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("location") {
+		return nil
+	}
+
+	tfMap, ok := d.Get("location.0.s3.0").(map[string]interface{})
+	if !ok || tfMap == nil {
+		return nil
+	}
+
+	// A pinned object version can't change underneath the plugin.
+	if v, ok := tfMap["object_version"].(string); ok && v != "" {
+		return nil
+	}
+
+	oldMD5, ok := d.Get("file_description.0.file_md5").(string)
+	if !ok || oldMD5 == "" {
+		return nil
+	}
+
+	bucketARN, err := arn.Parse(tfMap["bucket_arn"].(string))
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*conns.AWSClient)
+	conn := client.S3Conn(ctx)
+
+	region, err := s3manager.GetBucketRegionWithClient(ctx, conn, bucketARN.Resource, func(r *request.Request) {
+		r.Config.S3ForcePathStyle = conn.Config.S3ForcePathStyle
+		r.Config.Credentials = conn.Config.Credentials
+	})
+
+	if err != nil {
+		log.Printf("[WARN] reading MSK Connect Custom Plugin (%s) S3 bucket Region: %s", d.Id(), err)
+		return nil
+	}
+
+	if region != client.Region {
+		conn = s3.New(client.Session.Copy(&aws.Config{
+			Region: aws.String(region),
+		}))
+	}
+
+	output, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketARN.Resource),
+		Key:    aws.String(tfMap["file_key"].(string)),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] reading MSK Connect Custom Plugin (%s) S3 object: %s", d.Id(), err)
+		return nil
+	}
+
+	// The ETag of an object encrypted with SSE-KMS or SSE-C is not the MD5 digest of the object.
+	switch aws.StringValue(output.ServerSideEncryption) {
+	case s3.ServerSideEncryptionAwsKms, s3.ServerSideEncryptionAwsKmsDsse:
+		return nil
+	}
+	if aws.StringValue(output.SSECustomerAlgorithm) != "" {
+		return nil
+	}
+
+	// The ETag of a multipart upload is not the MD5 digest of the object.
+	newMD5 := strings.Trim(aws.StringValue(output.ETag), `"`)
+	if newMD5 == "" || strings.Contains(newMD5, "-") || strings.EqualFold(newMD5, oldMD5) {
+		return nil
+	}
+
+	if err := d.SetNew("file_description", []interface{}{map[string]interface{}{
+		"file_md5":  newMD5,
+		"file_size": aws.Int64Value(output.ContentLength),
+	}}); err != nil {
+		return err
+	}
+
+	return d.ForceNew("file_description")
+}
+
 func expandCustomPluginLocation(tfMap map[string]interface{}) *kafkaconnect.CustomPluginLocation {
 	if tfMap == nil {
 		return nil
@@ -250,6 +362,24 @@ func flattenCustomPluginLocationDescription(apiObject *kafkaconnect.CustomPlugin
 	return tfMap
 }
 
+func flattenCustomPluginFileDescription(apiObject *kafkaconnect.CustomPluginFileDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FileMd5; v != nil {
+		tfMap["file_md5"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.FileSize; v != nil {
+		tfMap["file_size"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
 func flattenS3LocationDescription(apiObject *kafkaconnect.S3LocationDescription) map[string]interface{} {
 	if apiObject == nil {
 		return nil
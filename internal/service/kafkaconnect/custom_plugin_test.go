@@ -36,6 +36,9 @@ func TestAccKafkaConnectCustomPlugin_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "content_type", "ZIP"),
 					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "file_description.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "file_description.0.file_md5", "aws_s3_object.test", "etag"),
+					resource.TestCheckResourceAttrSet(resourceName, "file_description.0.file_size"),
 					resource.TestCheckResourceAttrSet(resourceName, "latest_revision"),
 					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "location.0.s3.#", "1"),
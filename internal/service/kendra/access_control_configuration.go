@@ -0,0 +1,338 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_kendra_access_control_configuration", name="Access Control Configuration")
+func ResourceAccessControlConfiguration() *schema.Resource {
+	principalSchema := func() *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.ReadAccessType](),
+				},
+				"data_source_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 100),
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[types.PrincipalType](),
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessControlConfigurationCreate,
+		ReadWithoutTimeout:   resourceAccessControlConfigurationRead,
+		UpdateWithoutTimeout: resourceAccessControlConfigurationUpdate,
+		DeleteWithoutTimeout: resourceAccessControlConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_control_configuration_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_control_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 200,
+				Elem:     principalSchema(),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"hierarchical_access_control_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 30,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_list": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 200,
+							Elem:     principalSchema(),
+						},
+					},
+				},
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+		},
+	}
+}
+
+func resourceAccessControlConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	name := d.Get("name").(string)
+	indexId := d.Get("index_id").(string)
+	in := &kendra.CreateAccessControlConfigurationInput{
+		ClientToken: aws.String(id.UniqueId()),
+		IndexId:     aws.String(indexId),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("access_control_list"); ok && len(v.([]interface{})) > 0 {
+		in.AccessControlList = expandPrincipals(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hierarchical_access_control_list"); ok && len(v.([]interface{})) > 0 {
+		in.HierarchicalAccessControlList = expandHierarchicalPrincipals(v.([]interface{}))
+	}
+
+	out, err := conn.CreateAccessControlConfiguration(ctx, in)
+
+	if err != nil {
+		return diag.Errorf("creating Amazon Kendra Access Control Configuration (%s): %s", name, err)
+	}
+
+	if out == nil {
+		return diag.Errorf("creating Amazon Kendra Access Control Configuration (%s): empty output", name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", aws.ToString(out.Id), indexId))
+
+	return resourceAccessControlConfigurationRead(ctx, d, meta)
+}
+
+func resourceAccessControlConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := AccessControlConfigurationParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := FindAccessControlConfigurationByID(ctx, conn, id, indexId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Access Control Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Kendra Access Control Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("access_control_configuration_id", id)
+	if err := d.Set("access_control_list", flattenPrincipals(out.AccessControlList)); err != nil {
+		return diag.Errorf("setting access_control_list: %s", err)
+	}
+	d.Set("description", out.Description)
+	if err := d.Set("hierarchical_access_control_list", flattenHierarchicalPrincipals(out.HierarchicalAccessControlList)); err != nil {
+		return diag.Errorf("setting hierarchical_access_control_list: %s", err)
+	}
+	d.Set("index_id", indexId)
+	d.Set("name", out.Name)
+
+	return nil
+}
+
+func resourceAccessControlConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := AccessControlConfigurationParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &kendra.UpdateAccessControlConfigurationInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexId),
+	}
+
+	if d.HasChange("access_control_list") {
+		input.AccessControlList = expandPrincipals(d.Get("access_control_list").([]interface{}))
+
+		if input.AccessControlList == nil {
+			input.AccessControlList = []types.Principal{}
+		}
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("hierarchical_access_control_list") {
+		input.HierarchicalAccessControlList = expandHierarchicalPrincipals(d.Get("hierarchical_access_control_list").([]interface{}))
+
+		if input.HierarchicalAccessControlList == nil {
+			input.HierarchicalAccessControlList = []types.HierarchicalPrincipal{}
+		}
+	}
+
+	if d.HasChange("name") {
+		input.Name = aws.String(d.Get("name").(string))
+	}
+
+	_, err = conn.UpdateAccessControlConfiguration(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Kendra Access Control Configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceAccessControlConfigurationRead(ctx, d, meta)
+}
+
+func resourceAccessControlConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := AccessControlConfigurationParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Kendra Access Control Configuration %s", d.Id())
+	_, err = conn.DeleteAccessControlConfiguration(ctx, &kendra.DeleteAccessControlConfigurationInput{
+		Id:      aws.String(id),
+		IndexId: aws.String(indexId),
+	})
+
+	var notFound *types.ResourceNotFoundException
+
+	if errors.As(err, &notFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Kendra Access Control Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPrincipals(tfList []interface{}) []types.Principal {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.Principal, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Principal{
+			Access: types.ReadAccessType(tfMap["access"].(string)),
+			Name:   aws.String(tfMap["name"].(string)),
+			Type:   types.PrincipalType(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["data_source_id"].(string); ok && v != "" {
+			apiObject.DataSourceId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandHierarchicalPrincipals(tfList []interface{}) []types.HierarchicalPrincipal {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.HierarchicalPrincipal, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.HierarchicalPrincipal{
+			PrincipalList: expandPrincipals(tfMap["principal_list"].([]interface{})),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenPrincipals(apiObjects []types.Principal) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"access":         string(apiObject.Access),
+			"data_source_id": aws.ToString(apiObject.DataSourceId),
+			"name":           aws.ToString(apiObject.Name),
+			"type":           string(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenHierarchicalPrincipals(apiObjects []types.HierarchicalPrincipal) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"principal_list": flattenPrincipals(apiObject.PrincipalList),
+		})
+	}
+
+	return tfList
+}
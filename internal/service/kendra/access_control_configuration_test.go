@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkendra "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKendraAccessControlConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_access_control_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessControlConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessControlConfigurationConfig_basic(rName, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessControlConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "access_control_configuration_id"),
+					resource.TestCheckResourceAttr(resourceName, "access_control_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_control_list.0.access", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "access_control_list.0.name", "engineering"),
+					resource.TestCheckResourceAttr(resourceName, "access_control_list.0.type", "GROUP"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchical_access_control_list.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_kendra_index.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccessControlConfigurationConfig_basic(rName, "DENY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessControlConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_control_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "access_control_list.0.access", "DENY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKendraAccessControlConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kendra_access_control_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.KendraEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessControlConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessControlConfigurationConfig_basic(rName, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessControlConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkendra.ResourceAccessControlConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAccessControlConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kendra_access_control_configuration" {
+				continue
+			}
+
+			id, indexId, err := tfkendra.AccessControlConfigurationParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfkendra.FindAccessControlConfigurationByID(ctx, conn, id, indexId)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kendra Access Control Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAccessControlConfigurationExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kendra Access Control Configuration is set")
+		}
+
+		id, indexId, err := tfkendra.AccessControlConfigurationParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KendraClient(ctx)
+
+		_, err = tfkendra.FindAccessControlConfigurationByID(ctx, conn, id, indexId)
+
+		return err
+	}
+}
+
+func testAccAccessControlConfigurationConfig_basic(rName, access string) string {
+	return acctest.ConfigCompose(
+		testAccFeaturedResultsSetConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_kendra_access_control_configuration" "test" {
+  index_id = aws_kendra_index.test.id
+  name     = %[1]q
+
+  access_control_list {
+    access = %[2]q
+    name   = "engineering"
+    type   = "GROUP"
+  }
+}
+`, rName, access))
+}
@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kendra_featured_results_set", name="Featured Results Set")
+// @Tags(identifierAttribute="arn")
+func ResourceFeaturedResultsSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeaturedResultsSetCreate,
+		ReadWithoutTimeout:   resourceFeaturedResultsSetRead,
+		UpdateWithoutTimeout: resourceFeaturedResultsSetUpdate,
+		DeleteWithoutTimeout: resourceFeaturedResultsSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"featured_documents": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 4,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 2048),
+				},
+			},
+			"featured_documents_missing": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"featured_results_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_texts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 49,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1000),
+				},
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.FeaturedResultsSetStatusActive),
+				ValidateDiagFunc: enum.Validate[types.FeaturedResultsSetStatus](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFeaturedResultsSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	name := d.Get("name").(string)
+	indexId := d.Get("index_id").(string)
+	in := &kendra.CreateFeaturedResultsSetInput{
+		ClientToken:            aws.String(id.UniqueId()),
+		FeaturedResultsSetName: aws.String(name),
+		IndexId:                aws.String(indexId),
+		Status:                 types.FeaturedResultsSetStatus(d.Get("status").(string)),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("featured_documents"); ok && v.(*schema.Set).Len() > 0 {
+		in.FeaturedDocuments = expandFeaturedDocuments(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("query_texts"); ok && v.(*schema.Set).Len() > 0 {
+		in.QueryTexts = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	out, err := conn.CreateFeaturedResultsSet(ctx, in)
+
+	if err != nil {
+		return diag.Errorf("creating Amazon Kendra Featured Results Set (%s): %s", name, err)
+	}
+
+	if out == nil || out.FeaturedResultsSet == nil {
+		return diag.Errorf("creating Amazon Kendra Featured Results Set (%s): empty output", name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", aws.ToString(out.FeaturedResultsSet.FeaturedResultsSetId), indexId))
+
+	return resourceFeaturedResultsSetRead(ctx, d, meta)
+}
+
+func resourceFeaturedResultsSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, err := FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Featured Results Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "kendra",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/featured-results-set/%s", indexId, id),
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("description", out.Description)
+	d.Set("featured_results_set_id", id)
+	d.Set("index_id", indexId)
+	d.Set("name", out.FeaturedResultsSetName)
+	d.Set("query_texts", out.QueryTexts)
+	d.Set("status", out.Status)
+
+	if err := d.Set("featured_documents", flattenFeaturedDocumentsWithMetadata(out.FeaturedDocumentsWithMetadata, out.FeaturedDocumentsMissing)); err != nil {
+		return diag.Errorf("setting featured_documents: %s", err)
+	}
+
+	if err := d.Set("featured_documents_missing", flattenFeaturedDocumentsMissing(out.FeaturedDocumentsMissing)); err != nil {
+		return diag.Errorf("setting featured_documents_missing: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFeaturedResultsSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &kendra.UpdateFeaturedResultsSetInput{
+			FeaturedResultsSetId: aws.String(id),
+			IndexId:              aws.String(indexId),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("featured_documents") {
+			input.FeaturedDocuments = expandFeaturedDocuments(d.Get("featured_documents").(*schema.Set).List())
+
+			// A nil list isn't sent, so send an empty list to remove all the featured documents.
+			if input.FeaturedDocuments == nil {
+				input.FeaturedDocuments = []types.FeaturedDocument{}
+			}
+		}
+
+		if d.HasChange("name") {
+			input.FeaturedResultsSetName = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("query_texts") {
+			input.QueryTexts = flex.ExpandStringValueSet(d.Get("query_texts").(*schema.Set))
+		}
+
+		if d.HasChange("status") {
+			input.Status = types.FeaturedResultsSetStatus(d.Get("status").(string))
+		}
+
+		_, err = conn.UpdateFeaturedResultsSet(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Kendra Featured Results Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceFeaturedResultsSetRead(ctx, d, meta)
+}
+
+func resourceFeaturedResultsSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Kendra Featured Results Set %s", d.Id())
+	out, err := conn.BatchDeleteFeaturedResultsSet(ctx, &kendra.BatchDeleteFeaturedResultsSetInput{
+		FeaturedResultsSetIds: []string{id},
+		IndexId:               aws.String(indexId),
+	})
+
+	var notFound *types.ResourceNotFoundException
+
+	if errors.As(err, &notFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	if out != nil && len(out.Errors) > 0 {
+		return diag.Errorf("deleting Kendra Featured Results Set (%s): %s", d.Id(), aws.ToString(out.Errors[0].ErrorMessage))
+	}
+
+	return nil
+}
+
+func expandFeaturedDocuments(tfList []interface{}) []types.FeaturedDocument {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]types.FeaturedDocument, 0, len(tfList))
+
+	for _, v := range tfList {
+		v, ok := v.(string)
+
+		if !ok || v == "" {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.FeaturedDocument{
+			Id: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenFeaturedDocumentsWithMetadata returns the IDs of all featured documents, including
+// those that are not (yet) present in the index, so that configured documents don't show a diff.
+func flattenFeaturedDocumentsWithMetadata(apiObjects []types.FeaturedDocumentWithMetadata, missing []types.FeaturedDocumentMissing) []string {
+	tfList := make([]string, 0, len(apiObjects)+len(missing))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, aws.ToString(apiObject.Id))
+	}
+
+	tfList = append(tfList, flattenFeaturedDocumentsMissing(missing)...)
+
+	return tfList
+}
+
+func flattenFeaturedDocumentsMissing(apiObjects []types.FeaturedDocumentMissing) []string {
+	tfList := make([]string, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, aws.ToString(apiObject.Id))
+	}
+
+	return tfList
+}
@@ -38,6 +38,15 @@ const (
 	validationExceptionMessage = "Please make sure your role exists and has `kendra.amazonaws.com` as trusted entity"
 )
 
+const (
+	// indexEditionGenAIEnterpriseEdition is not yet modeled in the AWS SDK.
+	indexEditionGenAIEnterpriseEdition types.IndexEdition = "GEN_AI_ENTERPRISE_EDITION"
+)
+
+func indexEdition_Values() []string {
+	return append(enum.Values[types.IndexEdition](), string(indexEditionGenAIEnterpriseEdition))
+}
+
 // @SDKResource("aws_kendra_index", name="Index")
 // @Tags(identifierAttribute="arn")
 func ResourceIndex() *schema.Resource {
@@ -188,11 +197,11 @@ func ResourceIndex() *schema.Resource {
 				},
 			},
 			"edition": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         true,
-				Default:          string(types.IndexEditionEnterpriseEdition),
-				ValidateDiagFunc: enum.Validate[types.IndexEdition](),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(types.IndexEditionEnterpriseEdition),
+				ValidateFunc: validation.StringInSlice(indexEdition_Values(), false),
 			},
 			"error_message": {
 				Type:     schema.TypeString,
@@ -49,6 +49,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceAccessControlConfiguration,
+			TypeName: "aws_kendra_access_control_configuration",
+			Name:     "Access Control Configuration",
+		},
 		{
 			Factory:  ResourceDataSource,
 			TypeName: "aws_kendra_data_source",
@@ -69,6 +74,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceFeaturedResultsSet,
+			TypeName: "aws_kendra_featured_results_set",
+			Name:     "Featured Results Set",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
 		{
 			Factory:  ResourceIndex,
 			TypeName: "aws_kendra_index",
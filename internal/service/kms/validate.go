@@ -66,6 +66,11 @@ var ValidateKey = validation.Any(
 	validateKeyARN,
 )
 
+var ValidateKeyAlias = validation.Any(
+	validateKeyAliasName,
+	validateKeyAliasARN,
+)
+
 var ValidateKeyOrAlias = validation.Any(
 	validateKeyId,
 	validateKeyARN,
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,6 +40,8 @@ func ResourceFunctionURL() *schema.Resource {
 			Create: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffFunctionURLCors,
+
 		Schema: map[string]*schema.Schema{
 			"authorization_type": {
 				Type:         schema.TypeString,
@@ -114,15 +117,24 @@ func ResourceFunctionURL() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			"url_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"url_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"url_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
 func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
 
 	name := d.Get("function_name").(string)
@@ -151,32 +163,17 @@ func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(id)
 
-	if v := d.Get("authorization_type").(string); v == lambda.FunctionUrlAuthTypeNone {
-		input := &lambda.AddPermissionInput{
-			Action:              aws.String("lambda:InvokeFunctionUrl"),
-			FunctionName:        aws.String(name),
-			FunctionUrlAuthType: aws.String(v),
-			Principal:           aws.String("*"),
-			StatementId:         aws.String("FunctionURLAllowPublicAccess"),
-		}
-
-		if qualifier != "" {
-			input.Qualifier = aws.String(qualifier)
-		}
-
-		log.Printf("[DEBUG] Adding Lambda Permission: %s", input)
-		_, err := conn.AddPermissionWithContext(ctx, input)
+	if v := d.Get("invoke_mode").(string); v == lambda.InvokeModeResponseStream {
+		diags = append(diags, checkFunctionURLResponseStreamRuntime(ctx, conn, name, qualifier)...)
+	}
 
-		if err != nil {
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "The statement id (FunctionURLAllowPublicAccess) provided already exists") {
-				log.Printf("[DEBUG] function permission statement 'FunctionURLAllowPublicAccess' already exists.")
-			} else {
-				return diag.Errorf("adding Lambda Function URL (%s) permission %s", d.Id(), err)
-			}
+	if d.Get("authorization_type").(string) == lambda.FunctionUrlAuthTypeNone {
+		if err := addFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+			return append(diags, diag.Errorf("adding Lambda Function URL (%s) permission %s", d.Id(), err)...)
 		}
 	}
 
-	return resourceFunctionURLRead(ctx, d, meta)
+	return append(diags, resourceFunctionURLRead(ctx, d, meta)...)
 }
 
 func resourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -220,16 +217,21 @@ func resourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta i
 	// https://<url-id>.lambda-url.<region>.on.aws
 	if v, err := url.Parse(functionURL); err != nil {
 		return diag.Errorf("parsing URL (%s): %s", functionURL, err)
-	} else if v := strings.Split(v.Host, "."); len(v) > 0 {
-		d.Set("url_id", v[0])
 	} else {
-		d.Set("url_id", nil)
+		d.Set("url_host", v.Host)
+		d.Set("url_path", v.Path)
+		if v := strings.Split(v.Host, "."); len(v) > 0 {
+			d.Set("url_id", v[0])
+		} else {
+			d.Set("url_id", nil)
+		}
 	}
 
 	return nil
 }
 
 func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
 
 	name, qualifier, err := FunctionURLParseResourceID(d.Id())
@@ -260,16 +262,34 @@ func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("invoke_mode") {
 		input.InvokeMode = aws.String(d.Get("invoke_mode").(string))
+
+		if v := d.Get("invoke_mode").(string); v == lambda.InvokeModeResponseStream {
+			diags = append(diags, checkFunctionURLResponseStreamRuntime(ctx, conn, name, qualifier)...)
+		}
 	}
 
 	log.Printf("[DEBUG] Updating Lambda Function URL: %s", input)
 	_, err = conn.UpdateFunctionUrlConfigWithContext(ctx, input)
 
 	if err != nil {
-		return diag.Errorf("updating Lambda Function URL (%s): %s", d.Id(), err)
+		return append(diags, diag.Errorf("updating Lambda Function URL (%s): %s", d.Id(), err)...)
 	}
 
-	return resourceFunctionURLRead(ctx, d, meta)
+	// The public access permission is only managed for the NONE authorization type.
+	if d.HasChange("authorization_type") {
+		switch d.Get("authorization_type").(string) {
+		case lambda.FunctionUrlAuthTypeNone:
+			if err := addFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+				return append(diags, diag.Errorf("adding Lambda Function URL (%s) permission %s", d.Id(), err)...)
+			}
+		case lambda.FunctionUrlAuthTypeAwsIam:
+			if err := removeFunctionURLPublicAccessPermission(ctx, conn, name, qualifier); err != nil {
+				return append(diags, diag.Errorf("removing Lambda Function URL (%s) permission %s", d.Id(), err)...)
+			}
+		}
+	}
+
+	return append(diags, resourceFunctionURLRead(ctx, d, meta)...)
 }
 
 func resourceFunctionURLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -332,6 +352,106 @@ func FindFunctionURLByNameAndQualifier(ctx context.Context, conn *lambda.Lambda,
 	return output, nil
 }
 
+func customizeDiffFunctionURLCors(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("cors")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+
+	if v, ok := tfMap["allow_credentials"].(bool); !ok || !v {
+		return nil
+	}
+
+	// Browsers reject credentialed requests whose allowed origin is a wildcard.
+	if v, ok := tfMap["allow_origins"].(*schema.Set); ok && v.Contains("*") {
+		return fmt.Errorf(`cors.allow_origins cannot contain "*" when cors.allow_credentials is true`)
+	}
+
+	return nil
+}
+
+// checkFunctionURLResponseStreamRuntime returns a warning if the function's runtime does not natively support response streaming.
+func checkFunctionURLResponseStreamRuntime(ctx context.Context, conn *lambda.Lambda, name, qualifier string) diag.Diagnostics {
+	input := &lambda.GetFunctionConfigurationInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetFunctionConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		log.Printf("[WARN] reading Lambda Function (%s) configuration: %s", name, err)
+		return nil
+	}
+
+	// Container images and custom runtimes implement their own Runtime API integration.
+	runtime := aws.StringValue(output.Runtime)
+	if runtime == "" || strings.HasPrefix(runtime, "nodejs") || strings.HasPrefix(runtime, "provided") {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Response streaming not natively supported by runtime",
+			Detail:        fmt.Sprintf("Lambda Function (%s) uses the %s runtime. %s invoke mode is only natively supported by Node.js managed runtimes and custom runtimes; other runtimes require an extension such as the Lambda Web Adapter, otherwise responses are buffered.", name, runtime, lambda.InvokeModeResponseStream),
+			AttributePath: cty.GetAttrPath("invoke_mode"),
+		},
+	}
+}
+
+const functionURLPublicAccessStatementID = "FunctionURLAllowPublicAccess"
+
+func addFunctionURLPublicAccessPermission(ctx context.Context, conn *lambda.Lambda, name, qualifier string) error {
+	input := &lambda.AddPermissionInput{
+		Action:              aws.String("lambda:InvokeFunctionUrl"),
+		FunctionName:        aws.String(name),
+		FunctionUrlAuthType: aws.String(lambda.FunctionUrlAuthTypeNone),
+		Principal:           aws.String("*"),
+		StatementId:         aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Adding Lambda Permission: %s", input)
+	_, err := conn.AddPermissionWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "The statement id (FunctionURLAllowPublicAccess) provided already exists") {
+		log.Printf("[DEBUG] function permission statement 'FunctionURLAllowPublicAccess' already exists.")
+		return nil
+	}
+
+	return err
+}
+
+func removeFunctionURLPublicAccessPermission(ctx context.Context, conn *lambda.Lambda, name, qualifier string) error {
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(name),
+		StatementId:  aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Removing Lambda Permission: %s", input)
+	_, err := conn.RemovePermissionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
 const functionURLResourceIDSeparator = "/"
 
 func FunctionURLCreateResourceID(functionName, qualifier string) string {
@@ -88,10 +88,18 @@ func DataSourceFunctionURL() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"url_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"url_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"url_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -131,10 +139,14 @@ func dataSourceFunctionURLRead(ctx context.Context, d *schema.ResourceData, meta
 	// https://<url-id>.lambda-url.<region>.on.aws
 	if v, err := url.Parse(functionURL); err != nil {
 		return diag.Errorf("parsing URL (%s): %s", functionURL, err)
-	} else if v := strings.Split(v.Host, "."); len(v) > 0 {
-		d.Set("url_id", v[0])
 	} else {
-		d.Set("url_id", nil)
+		d.Set("url_host", v.Host)
+		d.Set("url_path", v.Path)
+		if v := strings.Split(v.Host, "."); len(v) > 0 {
+			d.Set("url_id", v[0])
+		} else {
+			d.Set("url_id", nil)
+		}
 	}
 
 	return nil
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -49,7 +51,9 @@ func TestAccLambdaFunctionURL_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "function_url"),
 					resource.TestCheckResourceAttr(resourceName, "invoke_mode", "BUFFERED"),
 					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestMatchResourceAttr(resourceName, "url_host", regexache.MustCompile(`\.lambda-url\.`)),
 					resource.TestCheckResourceAttrSet(resourceName, "url_id"),
+					resource.TestCheckResourceAttr(resourceName, "url_path", "/"),
 				),
 			},
 			{
@@ -276,6 +280,117 @@ func TestAccLambdaFunctionURL_invokeMode(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunctionURL_authorizationType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_basic(funcName, policyName, roleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
+					testAccCheckFunctionURLPublicAccessPermission(ctx, resourceName, true),
+				),
+			},
+			{
+				Config: testAccFunctionURLConfig_authorizationType(funcName, policyName, roleName, lambda.FunctionUrlAuthTypeAwsIam),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeAwsIam),
+					testAccCheckFunctionURLPublicAccessPermission(ctx, resourceName, false),
+				),
+			},
+			{
+				Config: testAccFunctionURLConfig_authorizationType(funcName, policyName, roleName, lambda.FunctionUrlAuthTypeNone),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
+					testAccCheckFunctionURLPublicAccessPermission(ctx, resourceName, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_corsCredentialsWildcardOrigin(t *testing.T) {
+	ctx := acctest.Context(t)
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionURLConfig_corsCredentialsWildcardOrigin(funcName, policyName, roleName),
+				ExpectError: regexache.MustCompile(`cors.allow_origins cannot contain "\*" when cors.allow_credentials is true`),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionURLPublicAccessPermission(ctx context.Context, n string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		name, qualifier, err := tflambda.FunctionURLParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn(ctx)
+
+		_, err = tflambda.FindPolicyStatementByTwoPartKey(ctx, conn, name, "FunctionURLAllowPublicAccess", qualifier)
+
+		if tfresource.NotFound(err) {
+			if exists {
+				return fmt.Errorf("Lambda Function URL %s public access permission not found", rs.Primary.ID)
+			}
+
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("Lambda Function URL %s public access permission still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFunctionURLExists(ctx context.Context, n string, v *lambda.GetFunctionUrlConfigOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -539,6 +654,45 @@ resource "aws_lambda_function_url" "test" {
 `, funcName, invokeMode))
 }
 
+func testAccFunctionURLConfig_authorizationType(funcName, policyName, roleName, authorizationType string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs14.x"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = %[2]q
+}
+`, funcName, authorizationType))
+}
+
+func testAccFunctionURLConfig_corsCredentialsWildcardOrigin(funcName, policyName, roleName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs14.x"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "NONE"
+
+  cors {
+    allow_credentials = true
+    allow_origins     = ["*"]
+  }
+}
+`, funcName))
+}
+
 func testAccFunctionURLConfig_two(funcName, aliasName, policyName, roleName string) string {
 	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
 resource "aws_lambda_function" "test" {
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_lambda_layer_version_permissions", name="Layer Version Permissions")
+func ResourceLayerVersionPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLayerVersionPermissionsCreate,
+		ReadWithoutTimeout:   resourceLayerVersionPermissionsRead,
+		UpdateWithoutTimeout: resourceLayerVersionPermissionsUpdate,
+		DeleteWithoutTimeout: resourceLayerVersionPermissionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "lambda:GetLayerVersion",
+			},
+			"layer_name": {
+				Type: schema.TypeString,
+				ValidateFunc: validation.Any(
+					validation.StringMatch(regexache.MustCompile(`^[a-zA-Z0-9-_]+$`), ""),
+					verify.ValidARN,
+				),
+				Required: true,
+				ForceNew: true,
+			},
+			"organization_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"principal": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"skip_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"statement_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_numbers": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func resourceLayerVersionPermissionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
+
+	layerName := d.Get("layer_name").(string)
+	statementID := d.Get("statement_id").(string)
+	id := LayerVersionPermissionsCreateResourceID(layerName, statementID)
+
+	var added []int64
+
+	for _, v := range d.Get("version_numbers").(*schema.Set).List() {
+		versionNumber := int64(v.(int))
+
+		if err := addLayerVersionPermission(ctx, conn, d, versionNumber); err != nil {
+			// Keep track of the permissions that were added so that they're removed when the tainted resource is replaced.
+			if len(added) > 0 {
+				d.SetId(id)
+				d.Set("version_numbers", added)
+			}
+
+			return sdkdiag.AppendErrorf(diags, "adding Lambda Layer Version Permissions (%s): %s", id, err)
+		}
+
+		added = append(added, versionNumber)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceLayerVersionPermissionsRead(ctx, d, meta)...)
+}
+
+func resourceLayerVersionPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
+
+	layerName, statementID, err := LayerVersionPermissionsParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Only the versions in state are inspected. On import there are none, so every version of the layer is.
+	var managedVersionNumbers []int64
+
+	if v := d.Get("version_numbers").(*schema.Set); v.Len() > 0 {
+		for _, v := range v.List() {
+			managedVersionNumbers = append(managedVersionNumbers, int64(v.(int)))
+		}
+	} else {
+		layerVersions, err := findLayerVersions(ctx, conn, &lambda.ListLayerVersionsInput{
+			LayerName: aws.String(layerName),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Layer Version Permissions (%s): listing versions: %s", d.Id(), err)
+		}
+
+		for _, v := range layerVersions {
+			managedVersionNumbers = append(managedVersionNumbers, aws.Int64Value(v.Version))
+		}
+	}
+
+	var statement *IAMPolicyStatement
+	var versionNumbers []int64
+
+	for _, versionNumber := range managedVersionNumbers {
+		s, err := FindLayerVersionPolicyStatementByThreePartKey(ctx, conn, layerName, versionNumber, statementID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Layer Version Permissions (%s): version %d: %s", d.Id(), versionNumber, err)
+		}
+
+		if statement == nil {
+			statement = s
+		}
+		versionNumbers = append(versionNumbers, versionNumber)
+	}
+
+	if !d.IsNewResource() && len(versionNumbers) == 0 {
+		log.Printf("[WARN] Lambda Layer Version Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("layer_name", layerName)
+	d.Set("statement_id", statementID)
+	d.Set("version_numbers", versionNumbers)
+
+	if statement != nil {
+		action, principal, organizationID, err := flattenLayerVersionPolicyStatement(statement)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Layer Version Permissions (%s): %s", d.Id(), err)
+		}
+
+		d.Set("action", action)
+		d.Set("organization_id", organizationID)
+		d.Set("principal", principal)
+	}
+
+	return diags
+}
+
+func resourceLayerVersionPermissionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
+
+	if d.HasChange("version_numbers") {
+		o, n := d.GetChange("version_numbers")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		for _, v := range os.Difference(ns).List() {
+			if err := removeLayerVersionPermission(ctx, conn, d, int64(v.(int))); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Layer Version Permissions (%s): %s", d.Id(), err)
+			}
+		}
+
+		for _, v := range ns.Difference(os).List() {
+			if err := addLayerVersionPermission(ctx, conn, d, int64(v.(int))); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Layer Version Permissions (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceLayerVersionPermissionsRead(ctx, d, meta)...)
+}
+
+func resourceLayerVersionPermissionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v, ok := d.GetOk("skip_destroy"); ok && v.(bool) {
+		log.Printf("[DEBUG] Retaining Lambda Layer Version Permissions %q", d.Id())
+		return diags
+	}
+
+	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
+
+	log.Printf("[INFO] Deleting Lambda Layer Version Permissions: %s", d.Id())
+	for _, v := range d.Get("version_numbers").(*schema.Set).List() {
+		if err := removeLayerVersionPermission(ctx, conn, d, int64(v.(int))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Lambda Layer Version Permissions (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+func addLayerVersionPermission(ctx context.Context, conn *lambda.Lambda, d *schema.ResourceData, versionNumber int64) error {
+	input := &lambda.AddLayerVersionPermissionInput{
+		Action:        aws.String(d.Get("action").(string)),
+		LayerName:     aws.String(d.Get("layer_name").(string)),
+		Principal:     aws.String(d.Get("principal").(string)),
+		StatementId:   aws.String(d.Get("statement_id").(string)),
+		VersionNumber: aws.Int64(versionNumber),
+	}
+
+	if v, ok := d.GetOk("organization_id"); ok {
+		input.OrganizationId = aws.String(v.(string))
+	}
+
+	_, err := conn.AddLayerVersionPermissionWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("adding permission to version %d: %w", versionNumber, err)
+	}
+
+	return nil
+}
+
+func removeLayerVersionPermission(ctx context.Context, conn *lambda.Lambda, d *schema.ResourceData, versionNumber int64) error {
+	_, err := conn.RemoveLayerVersionPermissionWithContext(ctx, &lambda.RemoveLayerVersionPermissionInput{
+		LayerName:     aws.String(d.Get("layer_name").(string)),
+		StatementId:   aws.String(d.Get("statement_id").(string)),
+		VersionNumber: aws.Int64(versionNumber),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("removing permission from version %d: %w", versionNumber, err)
+	}
+
+	return nil
+}
+
+func FindLayerVersionPolicyStatementByThreePartKey(ctx context.Context, conn *lambda.Lambda, layerName string, versionNumber int64, statementID string) (*IAMPolicyStatement, error) {
+	input := &lambda.GetLayerVersionPolicyInput{
+		LayerName:     aws.String(layerName),
+		VersionNumber: aws.Int64(versionNumber),
+	}
+
+	output, err := conn.GetLayerVersionPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	policy := &IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Policy)), policy); err != nil {
+		return nil, err
+	}
+
+	for _, v := range policy.Statements {
+		if v != nil && v.Sid == statementID {
+			return v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		Message:     fmt.Sprintf("Statement (%s) not found", statementID),
+		LastRequest: input,
+	}
+}
+
+func flattenLayerVersionPolicyStatement(statement *IAMPolicyStatement) (string, string, string, error) {
+	var action, principal, organizationID string
+
+	switch v := statement.Actions.(type) {
+	case string:
+		action = v
+	case []interface{}:
+		if len(v) > 0 {
+			action, _ = v[0].(string)
+		}
+	}
+
+	if len(statement.Principals) > 0 {
+		switch v := statement.Principals[0].Identifiers.(type) {
+		case []string:
+			if len(v) > 0 {
+				principal = v[0]
+			}
+		case string:
+			if v == "*" {
+				principal = v
+				break
+			}
+
+			principalARN, err := arn.Parse(v)
+
+			if err != nil {
+				return "", "", "", fmt.Errorf("parsing principal ARN (%s): %w", v, err)
+			}
+
+			principal = principalARN.AccountID
+		}
+	}
+
+	if len(statement.Conditions) > 0 {
+		if v, ok := statement.Conditions[0].Values.([]string); ok && len(v) > 0 {
+			organizationID = v[0]
+		}
+	}
+
+	return action, principal, organizationID, nil
+}
+
+const layerVersionPermissionsResourceIDSeparator = ","
+
+func LayerVersionPermissionsCreateResourceID(layerName, statementID string) string {
+	parts := []string{layerName, statementID}
+	id := strings.Join(parts, layerVersionPermissionsResourceIDSeparator)
+
+	return id
+}
+
+func LayerVersionPermissionsParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, layerVersionPermissionsResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected LAYER_NAME%[2]sSTATEMENT_ID or LAYER_ARN%[2]sSTATEMENT_ID", id, layerVersionPermissionsResourceIDSeparator)
+}
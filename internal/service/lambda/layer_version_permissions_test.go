@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLambdaLayerVersionPermissions_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version_permissions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionPermissionsConfig_basic(rName, "aws_lambda_layer_version.test1.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerVersionPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "lambda:GetLayerVersion"),
+					resource.TestCheckResourceAttrPair(resourceName, "layer_name", "aws_lambda_layer_version.test1", "layer_name"),
+					resource.TestCheckResourceAttr(resourceName, "organization_id", "o-0123456789"),
+					resource.TestCheckResourceAttr(resourceName, "principal", "*"),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "org"),
+					resource.TestCheckResourceAttr(resourceName, "version_numbers.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "version_numbers.*", "aws_lambda_layer_version.test1", "version"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+			{
+				Config: testAccLayerVersionPermissionsConfig_basic(rName, "aws_lambda_layer_version.test1.version, aws_lambda_layer_version.test2.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerVersionPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version_numbers.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "version_numbers.*", "aws_lambda_layer_version.test1", "version"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "version_numbers.*", "aws_lambda_layer_version.test2", "version"),
+				),
+			},
+			{
+				Config: testAccLayerVersionPermissionsConfig_basic(rName, "aws_lambda_layer_version.test2.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLayerVersionPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version_numbers.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "version_numbers.*", "aws_lambda_layer_version.test2", "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersionPermissions_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version_permissions.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionPermissionsConfig_basic(rName, "aws_lambda_layer_version.test1.version"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionPermissionsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceLayerVersionPermissions(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLayerVersionPermissionsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		layerName, statementID, err := tflambda.LayerVersionPermissionsParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		versionNumbers, err := testAccLayerVersionPermissionsVersionNumbers(rs)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn(ctx)
+
+		for _, versionNumber := range versionNumbers {
+			if _, err := tflambda.FindLayerVersionPolicyStatementByThreePartKey(ctx, conn, layerName, versionNumber, statementID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLayerVersionPermissionsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_layer_version_permissions" {
+				continue
+			}
+
+			layerName, statementID, err := tflambda.LayerVersionPermissionsParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			versionNumbers, err := testAccLayerVersionPermissionsVersionNumbers(rs)
+
+			if err != nil {
+				return err
+			}
+
+			for _, versionNumber := range versionNumbers {
+				_, err := tflambda.FindLayerVersionPolicyStatementByThreePartKey(ctx, conn, layerName, versionNumber, statementID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Lambda Layer Version Permissions %s still exist on version %d", rs.Primary.ID, versionNumber)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccLayerVersionPermissionsVersionNumbers(rs *terraform.ResourceState) ([]int64, error) {
+	n, err := strconv.Atoi(rs.Primary.Attributes["version_numbers.#"])
+
+	if err != nil {
+		return nil, err
+	}
+
+	var versionNumbers []int64
+
+	for i := 0; i < n; i++ {
+		v, err := strconv.ParseInt(rs.Primary.Attributes[fmt.Sprintf("version_numbers.%d", i)], 10, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		versionNumbers = append(versionNumbers, v)
+	}
+
+	return versionNumbers, nil
+}
+
+func testAccLayerVersionPermissionsConfig_basic(rName, versionNumbers string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test1" {
+  filename   = "test-fixtures/lambdatest.zip"
+  layer_name = %[1]q
+}
+
+resource "aws_lambda_layer_version" "test2" {
+  filename   = "test-fixtures/lambdatest.zip"
+  layer_name = %[1]q
+
+  depends_on = [aws_lambda_layer_version.test1]
+}
+
+resource "aws_lambda_layer_version_permissions" "test" {
+  layer_name      = aws_lambda_layer_version.test1.layer_name
+  version_numbers = [%[2]s]
+  statement_id    = "org"
+  principal       = "*"
+  organization_id = "o-0123456789"
+}
+`, rName, versionNumbers)
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_lambda_layer_versions")
+func DataSourceLayerVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLayerVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"compatible_architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(lambda.Architecture_Values(), false),
+			},
+			"compatible_runtime": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(lambda.Runtime_Values(), false),
+			},
+			"layer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"layer_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compatible_architectures": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"compatible_runtimes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_info": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func dataSourceLayerVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn(ctx)
+
+	layerName := d.Get("layer_name").(string)
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+	}
+
+	if v, ok := d.GetOk("compatible_architecture"); ok {
+		input.CompatibleArchitecture = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("compatible_runtime"); ok {
+		input.CompatibleRuntime = aws.String(v.(string))
+	}
+
+	output, err := findLayerVersions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Lambda Layer Versions (%s): %s", layerName, err)
+	}
+
+	var versions []int64
+	for _, v := range output {
+		versions = append(versions, aws.Int64Value(v.Version))
+	}
+
+	d.SetId(layerName)
+	if err := d.Set("layer_versions", flattenLayerVersionsListItems(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting layer_versions: %s", err)
+	}
+	d.Set("versions", versions)
+
+	return diags
+}
+
+func findLayerVersions(ctx context.Context, conn *lambda.Lambda, input *lambda.ListLayerVersionsInput) ([]*lambda.LayerVersionsListItem, error) {
+	var output []*lambda.LayerVersionsListItem
+
+	err := conn.ListLayerVersionsPagesWithContext(ctx, input, func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LayerVersions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenLayerVersionsListItems(apiObjects []*lambda.LayerVersionsListItem) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                      aws.StringValue(apiObject.LayerVersionArn),
+			"compatible_architectures": flex.FlattenStringSet(apiObject.CompatibleArchitectures),
+			"compatible_runtimes":      flex.FlattenStringSet(apiObject.CompatibleRuntimes),
+			"created_date":             aws.StringValue(apiObject.CreatedDate),
+			"description":              aws.StringValue(apiObject.Description),
+			"license_info":             aws.StringValue(apiObject.LicenseInfo),
+			"version":                  aws.Int64Value(apiObject.Version),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccLambdaLayerVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0", "aws_lambda_layer_version.python", "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.arn", "aws_lambda_layer_version.python", "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.0.compatible_runtimes.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.1", "aws_lambda_layer_version.nodejs", "version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersionsDataSource_compatibleRuntime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "versions.0", "aws_lambda_layer_version.nodejs", "version"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "layer_versions.0.compatible_runtimes.*", "nodejs18.x"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLayerVersionsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "nodejs" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = ["nodejs18.x"]
+}
+
+resource "aws_lambda_layer_version" "python" {
+  filename            = "test-fixtures/lambdatest.zip"
+  layer_name          = %[1]q
+  compatible_runtimes = ["python3.11"]
+
+  depends_on = [aws_lambda_layer_version.nodejs]
+}
+`, rName)
+}
+
+func testAccLayerVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name = aws_lambda_layer_version.python.layer_name
+}
+`)
+}
+
+func testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name         = aws_lambda_layer_version.python.layer_name
+  compatible_runtime = "nodejs18.x"
+}
+`)
+}
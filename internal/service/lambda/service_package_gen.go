@@ -55,6 +55,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceLayerVersion,
 			TypeName: "aws_lambda_layer_version",
 		},
+		{
+			Factory:  DataSourceLayerVersions,
+			TypeName: "aws_lambda_layer_versions",
+		},
 	}
 }
 
@@ -100,6 +104,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceLayerVersionPermission,
 			TypeName: "aws_lambda_layer_version_permission",
 		},
+		{
+			Factory:  ResourceLayerVersionPermissions,
+			TypeName: "aws_lambda_layer_version_permissions",
+			Name:     "Layer Version Permissions",
+		},
 		{
 			Factory:  ResourcePermission,
 			TypeName: "aws_lambda_permission",
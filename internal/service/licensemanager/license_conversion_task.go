@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_licensemanager_license_conversion_task")
+func ResourceLicenseConversionTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLicenseConversionTaskCreate,
+		ReadWithoutTimeout:   resourceLicenseConversionTaskRead,
+		DeleteWithoutTimeout: schema.NoopContext, // A license conversion can't be cancelled. Convert back with a new task.
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_license_context": licenseContextSchema(),
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"license_conversion_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_license_context": licenseContextSchema(),
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func licenseContextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"usage_operation": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceLicenseConversionTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	resourceARN := d.Get("resource_arn").(string)
+	input := &licensemanager.CreateLicenseConversionTaskForResourceInput{
+		DestinationLicenseContext: expandLicenseConversionContext(d.Get("destination_license_context").([]interface{})),
+		ResourceArn:               aws.String(resourceARN),
+		SourceLicenseContext:      expandLicenseConversionContext(d.Get("source_license_context").([]interface{})),
+	}
+
+	output, err := conn.CreateLicenseConversionTaskForResourceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating License Manager License Conversion Task (%s): %s", resourceARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.LicenseConversionTaskId))
+
+	if _, err := waitLicenseConversionTaskSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for License Manager License Conversion Task (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceLicenseConversionTaskRead(ctx, d, meta)
+}
+
+func resourceLicenseConversionTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	output, err := FindLicenseConversionTaskByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] License Manager License Conversion Task %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading License Manager License Conversion Task (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("destination_license_context", flattenLicenseConversionContext(output.DestinationLicenseContext)); err != nil {
+		return diag.Errorf("setting destination_license_context: %s", err)
+	}
+	if output.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(output.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	if output.LicenseConversionTime != nil {
+		d.Set("license_conversion_time", aws.TimeValue(output.LicenseConversionTime).Format(time.RFC3339))
+	} else {
+		d.Set("license_conversion_time", nil)
+	}
+	d.Set("resource_arn", output.ResourceArn)
+	if err := d.Set("source_license_context", flattenLicenseConversionContext(output.SourceLicenseContext)); err != nil {
+		return diag.Errorf("setting source_license_context: %s", err)
+	}
+	if output.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+
+	return nil
+}
+
+func FindLicenseConversionTaskByID(ctx context.Context, conn *licensemanager.LicenseManager, id string) (*licensemanager.GetLicenseConversionTaskOutput, error) {
+	input := &licensemanager.GetLicenseConversionTaskInput{
+		LicenseConversionTaskId: aws.String(id),
+	}
+
+	output, err := conn.GetLicenseConversionTaskWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusLicenseConversionTask(ctx context.Context, conn *licensemanager.LicenseManager, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLicenseConversionTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitLicenseConversionTaskSucceeded(ctx context.Context, conn *licensemanager.LicenseManager, id string, timeout time.Duration) (*licensemanager.GetLicenseConversionTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{licensemanager.LicenseConversionTaskStatusInProgress},
+		Target:  []string{licensemanager.LicenseConversionTaskStatusSucceeded},
+		Refresh: statusLicenseConversionTask(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*licensemanager.GetLicenseConversionTaskOutput); ok {
+		if status := aws.StringValue(output.Status); status == licensemanager.LicenseConversionTaskStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandLicenseConversionContext(tfList []interface{}) *licensemanager.LicenseConversionContext {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &licensemanager.LicenseConversionContext{}
+
+	if v, ok := tfMap["usage_operation"].(string); ok && v != "" {
+		apiObject.UsageOperation = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLicenseConversionContext(apiObject *licensemanager.LicenseConversionContext) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"usage_operation": aws.StringValue(apiObject.UsageOperation),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tflicensemanager "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
+)
+
+const (
+	conversionInstanceARNKey = "TF_AWS_LICENSE_MANAGER_CONVERSION_INSTANCE_ARN"
+)
+
+const (
+	envVarConversionInstanceARNError = "ARN of a running, SSM-managed Windows Server license-included EC2 instance whose license type can be converted to BYOL."
+)
+
+func TestAccLicenseManagerLicenseConversionTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	instanceARN := envvar.SkipIfEmpty(t, conversionInstanceARNKey, envVarConversionInstanceARNError)
+	var v licensemanager.GetLicenseConversionTaskOutput
+	resourceName := "aws_licensemanager_license_conversion_task.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, licensemanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseConversionTaskConfig_basic(instanceARN, "RunInstances:0002", "RunInstances:0800"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLicenseConversionTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination_license_context.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_license_context.0.usage_operation", "RunInstances:0800"),
+					resource.TestCheckResourceAttrSet(resourceName, "license_conversion_time"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arn", instanceARN),
+					resource.TestCheckResourceAttr(resourceName, "source_license_context.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_license_context.0.usage_operation", "RunInstances:0002"),
+					resource.TestCheckResourceAttr(resourceName, "status", licensemanager.LicenseConversionTaskStatusSucceeded),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Convert back so the instance can be reused.
+			{
+				Config: testAccLicenseConversionTaskConfig_basic(instanceARN, "RunInstances:0800", "RunInstances:0002"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLicenseConversionTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination_license_context.0.usage_operation", "RunInstances:0002"),
+					resource.TestCheckResourceAttr(resourceName, "status", licensemanager.LicenseConversionTaskStatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLicenseConversionTaskExists(ctx context.Context, n string, v *licensemanager.GetLicenseConversionTaskOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No License Manager License Conversion Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn(ctx)
+
+		output, err := tflicensemanager.FindLicenseConversionTaskByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLicenseConversionTaskConfig_basic(instanceARN, sourceUsageOperation, destinationUsageOperation string) string {
+	return fmt.Sprintf(`
+resource "aws_licensemanager_license_conversion_task" "test" {
+  resource_arn = %[1]q
+
+  source_license_context {
+    usage_operation = %[2]q
+  }
+
+  destination_license_context {
+    usage_operation = %[3]q
+  }
+}
+`, instanceARN, sourceUsageOperation, destinationUsageOperation)
+}
@@ -62,6 +62,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  ResourceLicenseConversionTask,
+			TypeName: "aws_licensemanager_license_conversion_task",
+		},
 	}
 }
 
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_managedblockchain_accessor", name="Accessor")
+// @Tags(identifierAttribute="arn")
+func ResourceAccessor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccessorCreate,
+		ReadWithoutTimeout:   resourceAccessorRead,
+		UpdateWithoutTimeout: resourceAccessorUpdate,
+		DeleteWithoutTimeout: resourceAccessorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"accessor_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      managedblockchain.AccessorTypeBillingToken,
+				ValidateFunc: validation.StringInSlice(managedblockchain.AccessorType_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billing_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceAccessorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	input := &managedblockchain.CreateAccessorInput{
+		AccessorType:       aws.String(d.Get("accessor_type").(string)),
+		ClientRequestToken: aws.String(id.UniqueId()),
+		Tags:               getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateAccessorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Managed Blockchain Accessor: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.AccessorId))
+
+	if _, err := waitAccessorCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Accessor (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAccessorRead(ctx, d, meta)...)
+}
+
+func resourceAccessorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	accessor, err := FindAccessorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Accessor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Managed Blockchain Accessor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("accessor_type", accessor.Type)
+	d.Set("arn", accessor.Arn)
+	d.Set("billing_token", accessor.BillingToken)
+	d.Set("creation_date", aws.TimeValue(accessor.CreationDate).Format(time.RFC3339))
+	d.Set("status", accessor.Status)
+
+	setTagsOut(ctx, accessor.Tags)
+
+	return diags
+}
+
+func resourceAccessorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceAccessorRead(ctx, d, meta)
+}
+
+func resourceAccessorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn(ctx)
+
+	log.Printf("[INFO] Deleting Managed Blockchain Accessor: %s", d.Id())
+	_, err := conn.DeleteAccessorWithContext(ctx, &managedblockchain.DeleteAccessorInput{
+		AccessorId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Managed Blockchain Accessor (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAccessorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Managed Blockchain Accessor (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAccessorByID(ctx context.Context, conn *managedblockchain.ManagedBlockchain, id string) (*managedblockchain.Accessor, error) {
+	input := &managedblockchain.GetAccessorInput{
+		AccessorId: aws.String(id),
+	}
+
+	output, err := conn.GetAccessorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Accessor == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Accessor.Status); status == managedblockchain.AccessorStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Accessor, nil
+}
+
+func statusAccessor(ctx context.Context, conn *managedblockchain.ManagedBlockchain, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAccessorByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitAccessorCreated(ctx context.Context, conn *managedblockchain.ManagedBlockchain, id string, timeout time.Duration) (*managedblockchain.Accessor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{accessorStatusPendingCreation},
+		Target:  []string{managedblockchain.AccessorStatusAvailable},
+		Refresh: statusAccessor(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedblockchain.Accessor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAccessorDeleted(ctx context.Context, conn *managedblockchain.ManagedBlockchain, id string, timeout time.Duration) (*managedblockchain.Accessor, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{managedblockchain.AccessorStatusAvailable, managedblockchain.AccessorStatusPendingDeletion},
+		Target:  []string{},
+		Refresh: statusAccessor(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedblockchain.Accessor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchain
+
+const (
+	// Not yet defined in the AWS SDK for Go.
+	accessorStatusPendingCreation = "PENDING_CREATION"
+)
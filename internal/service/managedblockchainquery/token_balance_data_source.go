@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchainquery
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchainquery"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_managedblockchainquery_token_balance")
+func DataSourceTokenBalance() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTokenBalanceRead,
+
+		Schema: map[string]*schema.Schema{
+			"at_blockchain_instant_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"balance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contract_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(managedblockchainquery.QueryNetwork_Values(), false),
+			},
+			"owner_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"token_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceTokenBalanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainQueryConn(ctx)
+
+	network := d.Get("network").(string)
+	ownerAddress := d.Get("owner_address").(string)
+	input := &managedblockchainquery.GetTokenBalanceInput{
+		OwnerIdentifier: &managedblockchainquery.OwnerIdentifier{
+			Address: aws.String(ownerAddress),
+		},
+		TokenIdentifier: &managedblockchainquery.TokenIdentifier{
+			Network: aws.String(network),
+		},
+	}
+
+	if v, ok := d.GetOk("at_blockchain_instant_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.AtBlockchainInstant = &managedblockchainquery.BlockchainInstant{
+			Time: aws.Time(v),
+		}
+	}
+
+	if v, ok := d.GetOk("contract_address"); ok {
+		input.TokenIdentifier.ContractAddress = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("token_id"); ok {
+		input.TokenIdentifier.TokenId = aws.String(v.(string))
+	}
+
+	output, err := findTokenBalance(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Managed Blockchain Query Token Balance (%s): %s", ownerAddress, err)
+	}
+
+	d.SetId(strings.Join([]string{network, d.Get("contract_address").(string), d.Get("token_id").(string), ownerAddress}, ","))
+	if v := output.AtBlockchainInstant; v != nil && v.Time != nil {
+		d.Set("at_blockchain_instant_time", aws.TimeValue(v.Time).Format(time.RFC3339))
+	}
+	d.Set("balance", output.Balance)
+	if v := output.LastUpdatedTime; v != nil && v.Time != nil {
+		d.Set("last_updated_time", aws.TimeValue(v.Time).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_time", nil)
+	}
+
+	return diags
+}
+
+func findTokenBalance(ctx context.Context, conn *managedblockchainquery.ManagedBlockchainQuery, input *managedblockchainquery.GetTokenBalanceInput) (*managedblockchainquery.GetTokenBalanceOutput, error) {
+	output, err := conn.GetTokenBalanceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchainquery.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Balance == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchainquery_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/managedblockchainquery"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccManagedBlockchainQueryTokenBalanceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_managedblockchainquery_token_balance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchainquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTokenBalanceDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "at_blockchain_instant_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "balance"),
+					resource.TestCheckResourceAttr(dataSourceName, "network", managedblockchainquery.QueryNetworkEthereumMainnet),
+					resource.TestCheckResourceAttr(dataSourceName, "token_id", "eth"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainQueryTokenBalanceDataSource_atBlockchainInstantTime(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_managedblockchainquery_token_balance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchainquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTokenBalanceDataSourceConfig_atBlockchainInstantTime("2023-01-01T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "at_blockchain_instant_time", "2023-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "balance"),
+				),
+			},
+		},
+	})
+}
+
+// The owner is the Ethereum Foundation's well-known address.
+func testAccTokenBalanceDataSourceConfig_basic() string {
+	return `
+data "aws_managedblockchainquery_token_balance" "test" {
+  network       = "ETHEREUM_MAINNET"
+  owner_address = "0xde0B295669a9FD93d5F28D9Ec85E40f4cb697BAe"
+  token_id      = "eth"
+}
+`
+}
+
+func testAccTokenBalanceDataSourceConfig_atBlockchainInstantTime(atTime string) string {
+	return fmt.Sprintf(`
+data "aws_managedblockchainquery_token_balance" "test" {
+  network                    = "ETHEREUM_MAINNET"
+  owner_address              = "0xde0B295669a9FD93d5F28D9Ec85E40f4cb697BAe"
+  token_id                   = "eth"
+  at_blockchain_instant_time = %[1]q
+}
+`, atTime)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchainquery
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchainquery"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKDataSource("aws_managedblockchainquery_transaction")
+func DataSourceTransaction() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTransactionRead,
+
+		Schema: map[string]*schema.Schema{
+			"block_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"block_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contract_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cumulative_gas_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"effective_gas_price": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gas_used": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(managedblockchainquery.QueryNetwork_Values(), false),
+			},
+			"number_of_transactions": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"signature_r": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signature_s": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signature_v": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"to": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transaction_fee": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transaction_hash": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"transaction_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transaction_index": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"transaction_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransactionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ManagedBlockchainQueryConn(ctx)
+
+	network := d.Get("network").(string)
+	transactionHash := d.Get("transaction_hash").(string)
+	transaction, err := findTransactionByTwoPartKey(ctx, conn, network, transactionHash)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Managed Blockchain Query Transaction (%s): %s", transactionHash, err)
+	}
+
+	d.SetId(strings.Join([]string{network, transactionHash}, ","))
+	d.Set("block_hash", transaction.BlockHash)
+	d.Set("block_number", transaction.BlockNumber)
+	d.Set("contract_address", transaction.ContractAddress)
+	d.Set("cumulative_gas_used", transaction.CumulativeGasUsed)
+	d.Set("effective_gas_price", transaction.EffectiveGasPrice)
+	d.Set("from", transaction.From)
+	d.Set("gas_used", transaction.GasUsed)
+	d.Set("number_of_transactions", transaction.NumberOfTransactions)
+	d.Set("signature_r", transaction.SignatureR)
+	d.Set("signature_s", transaction.SignatureS)
+	d.Set("signature_v", transaction.SignatureV)
+	d.Set("status", transaction.Status)
+	d.Set("to", transaction.To)
+	d.Set("transaction_fee", transaction.TransactionFee)
+	d.Set("transaction_id", transaction.TransactionId)
+	d.Set("transaction_index", transaction.TransactionIndex)
+	d.Set("transaction_timestamp", aws.TimeValue(transaction.TransactionTimestamp).Format(time.RFC3339))
+
+	return diags
+}
+
+func findTransactionByTwoPartKey(ctx context.Context, conn *managedblockchainquery.ManagedBlockchainQuery, network, transactionHash string) (*managedblockchainquery.Transaction, error) {
+	input := &managedblockchainquery.GetTransactionInput{
+		Network:         aws.String(network),
+		TransactionHash: aws.String(transactionHash),
+	}
+
+	output, err := conn.GetTransactionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchainquery.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Transaction == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Transaction, nil
+}
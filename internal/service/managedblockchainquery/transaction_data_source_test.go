@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedblockchainquery_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/managedblockchainquery"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccManagedBlockchainQueryTransactionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_managedblockchainquery_transaction.test"
+	// Bitcoin "pizza" transaction, block 57043.
+	transactionHash := "a1075db55d416d3ca199f55b6084e2115b9345e16c5cf302fc80e9d5fbf5d48d"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, endpoints.UsEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchainquery.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransactionDataSourceConfig_basic(managedblockchainquery.QueryNetworkBitcoinMainnet, transactionHash),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "block_number", "57043"),
+					resource.TestCheckResourceAttr(dataSourceName, "network", managedblockchainquery.QueryNetworkBitcoinMainnet),
+					resource.TestCheckResourceAttr(dataSourceName, "status", managedblockchainquery.QueryTransactionStatusFinal),
+					resource.TestCheckResourceAttr(dataSourceName, "transaction_hash", transactionHash),
+					resource.TestCheckResourceAttrSet(dataSourceName, "transaction_timestamp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransactionDataSourceConfig_basic(network, transactionHash string) string {
+	return fmt.Sprintf(`
+data "aws_managedblockchainquery_transaction" "test" {
+  network          = %[1]q
+  transaction_hash = %[2]q
+}
+`, network, transactionHash)
+}
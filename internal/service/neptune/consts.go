@@ -12,10 +12,11 @@ const (
 )
 
 const (
-	GlobalClusterStatusAvailable = "available"
-	GlobalClusterStatusCreating  = "creating"
-	GlobalClusterStatusDeleted   = "deleted"
-	GlobalClusterStatusDeleting  = "deleting"
-	GlobalClusterStatusModifying = "modifying"
-	GlobalClusterStatusUpgrading = "upgrading"
+	GlobalClusterStatusAvailable   = "available"
+	GlobalClusterStatusCreating    = "creating"
+	GlobalClusterStatusDeleted     = "deleted"
+	GlobalClusterStatusDeleting    = "deleting"
+	GlobalClusterStatusFailingOver = "failing-over"
+	GlobalClusterStatusModifying   = "modifying"
+	GlobalClusterStatusUpgrading   = "upgrading"
 )
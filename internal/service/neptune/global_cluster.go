@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_neptune_global_cluster")
@@ -104,6 +105,12 @@ func ResourceGlobalCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"writer_db_cluster_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 		},
 	}
 }
@@ -176,6 +183,7 @@ func resourceGlobalClusterRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.Set("global_cluster_resource_id", globalCluster.GlobalClusterResourceId)
 	d.Set("storage_encrypted", globalCluster.StorageEncrypted)
+	d.Set("writer_db_cluster_arn", globalClusterWriterARN(globalCluster))
 
 	return nil
 }
@@ -243,6 +251,32 @@ func resourceGlobalClusterUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("writer_db_cluster_arn") {
+		if targetARN := d.Get("writer_db_cluster_arn").(string); targetARN != "" {
+			globalCluster, err := FindGlobalClusterByID(ctx, conn, d.Id())
+
+			if err != nil {
+				return diag.Errorf("reading Neptune Global Cluster (%s): %s", d.Id(), err)
+			}
+
+			// Only fail over when the target is not already the primary cluster.
+			if globalClusterWriterARN(globalCluster) != targetARN {
+				input := &neptune.FailoverGlobalClusterInput{
+					GlobalClusterIdentifier:   aws.String(d.Id()),
+					TargetDbClusterIdentifier: aws.String(targetARN),
+				}
+
+				if _, err := conn.FailoverGlobalClusterWithContext(ctx, input); err != nil {
+					return diag.Errorf("failing over Neptune Global Cluster (%s) to %s: %s", d.Id(), targetARN, err)
+				}
+
+				if _, err := waitGlobalClusterFailedOver(ctx, conn, d.Id(), targetARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.Errorf("waiting for Neptune Global Cluster (%s) failover: %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
 	return resourceGlobalClusterRead(ctx, d, meta)
 }
 
@@ -419,6 +453,47 @@ func waitGlobalClusterUpdated(ctx context.Context, conn *neptune.Neptune, id str
 	return nil, err
 }
 
+func statusGlobalClusterWriter(ctx context.Context, conn *neptune.Neptune, id, writerARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalClusterByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.Status)
+
+		// The global cluster can report itself available before the new primary cluster has been promoted.
+		if status == GlobalClusterStatusAvailable && globalClusterWriterARN(output) != writerARN {
+			return output, GlobalClusterStatusFailingOver, nil
+		}
+
+		return output, status, nil
+	}
+}
+
+func waitGlobalClusterFailedOver(ctx context.Context, conn *neptune.Neptune, id, writerARN string, timeout time.Duration) (*neptune.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{GlobalClusterStatusFailingOver, GlobalClusterStatusModifying},
+		Target:  []string{GlobalClusterStatusAvailable},
+		Refresh: statusGlobalClusterWriter(ctx, conn, id, writerARN),
+		Timeout: timeout,
+		Delay:   30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptune.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitGlobalClusterDeleted(ctx context.Context, conn *neptune.Neptune, id string, timeout time.Duration) (*neptune.GlobalCluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        []string{GlobalClusterStatusAvailable, GlobalClusterStatusDeleting},
@@ -455,3 +530,13 @@ func flattenGlobalClusterMembers(apiObjects []*neptune.GlobalClusterMember) []in
 
 	return tfList
 }
+
+func globalClusterWriterARN(globalCluster *neptune.GlobalCluster) string {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.BoolValue(v.IsWriter) {
+			return aws.StringValue(v.DBClusterArn)
+		}
+	}
+
+	return ""
+}
@@ -311,6 +311,44 @@ func TestAccNeptuneGlobalCluster_StorageEncrypted(t *testing.T) {
 	})
 }
 
+func TestAccNeptuneGlobalCluster_writerDBClusterARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v neptune.GlobalCluster
+	rNameGlobal := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePrimary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameSecondary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_neptune_global_cluster.test"
+	primaryClusterResourceName := "aws_neptune_cluster.primary"
+	secondaryClusterResourceName := "aws_neptune_cluster.secondary"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckGlobalCluster(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, neptune.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckGlobalClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterConfig_writerDBClusterARN(rNameGlobal, rNamePrimary, rNameSecondary, "primary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "writer_db_cluster_arn", primaryClusterResourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccGlobalClusterConfig_writerDBClusterARN(rNameGlobal, rNamePrimary, rNameSecondary, "secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalClusterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "writer_db_cluster_arn", secondaryClusterResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGlobalClusterExists(ctx context.Context, n string, v *neptune.GlobalCluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -542,3 +580,112 @@ resource "aws_neptune_global_cluster" "test" {
 }
 `, rName, storageEncrypted)
 }
+
+func testAccGlobalClusterConfig_writerDBClusterARN(rNameGlobal, rNamePrimary, rNameSecondary, writer string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+  state    = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+locals {
+  # Cluster ARNs are built from their identifiers to avoid a dependency cycle with the global cluster.
+  cluster_arns = {
+    primary   = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:cluster:%[2]s"
+    secondary = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.alternate.name}:${data.aws_caller_identity.current.account_id}:cluster:%[3]s"
+  }
+}
+
+resource "aws_neptune_global_cluster" "test" {
+  engine                    = "neptune"
+  engine_version            = "1.2.0.0"
+  global_cluster_identifier = %[1]q
+  writer_db_cluster_arn     = local.cluster_arns[%[4]q]
+}
+
+resource "aws_neptune_cluster" "primary" {
+  apply_immediately                    = true
+  cluster_identifier                   = %[2]q
+  engine                               = aws_neptune_global_cluster.test.engine
+  engine_version                       = aws_neptune_global_cluster.test.engine_version
+  global_cluster_identifier            = aws_neptune_global_cluster.test.id
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+  skip_final_snapshot                  = true
+}
+
+resource "aws_neptune_cluster_instance" "primary" {
+  apply_immediately  = true
+  cluster_identifier = aws_neptune_cluster.primary.id
+  identifier         = %[2]q
+  instance_class     = "db.r5.large"
+}
+
+resource "aws_vpc" "alternate" {
+  provider   = "awsalternate"
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_subnet" "alternate" {
+  provider          = "awsalternate"
+  count             = 3
+  vpc_id            = aws_vpc.alternate.id
+  availability_zone = data.aws_availability_zones.alternate.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+
+  tags = {
+    Name = %[3]q
+  }
+}
+
+resource "aws_neptune_subnet_group" "alternate" {
+  provider   = "awsalternate"
+  name       = %[3]q
+  subnet_ids = aws_subnet.alternate[*].id
+}
+
+resource "aws_neptune_cluster" "secondary" {
+  provider                             = "awsalternate"
+  apply_immediately                    = true
+  cluster_identifier                   = %[3]q
+  engine                               = aws_neptune_global_cluster.test.engine
+  engine_version                       = aws_neptune_global_cluster.test.engine_version
+  global_cluster_identifier            = aws_neptune_global_cluster.test.id
+  neptune_cluster_parameter_group_name = "default.neptune1.2"
+  neptune_subnet_group_name            = aws_neptune_subnet_group.alternate.name
+  skip_final_snapshot                  = true
+
+  lifecycle {
+    ignore_changes = [replication_source_identifier]
+  }
+
+  depends_on = [aws_neptune_cluster_instance.primary]
+}
+
+resource "aws_neptune_cluster_instance" "secondary" {
+  provider           = "awsalternate"
+  apply_immediately  = true
+  cluster_identifier = aws_neptune_cluster.secondary.id
+  identifier         = %[3]q
+  instance_class     = "db.r5.large"
+}
+`, rNameGlobal, rNamePrimary, rNameSecondary, writer))
+}
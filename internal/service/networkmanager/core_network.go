@@ -343,6 +343,19 @@ func FindCoreNetworkPolicyByTwoPartKey(ctx context.Context, conn *networkmanager
 		input.PolicyVersionId = aws.Int64(policyVersionID)
 	}
 
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func FindCoreNetworkPolicyByAlias(ctx context.Context, conn *networkmanager.NetworkManager, coreNetworkID, alias string) (*networkmanager.CoreNetworkPolicy, error) {
+	input := &networkmanager.GetCoreNetworkPolicyInput{
+		Alias:         aws.String(alias),
+		CoreNetworkId: aws.String(coreNetworkID),
+	}
+
+	return findCoreNetworkPolicy(ctx, conn, input)
+}
+
+func findCoreNetworkPolicy(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetCoreNetworkPolicyInput) (*networkmanager.CoreNetworkPolicy, error) {
 	output, err := conn.GetCoreNetworkPolicyWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
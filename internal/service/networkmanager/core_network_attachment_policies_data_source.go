@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmanager
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_networkmanager_core_network_attachment_policies")
+func DataSourceCoreNetworkAttachmentPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCoreNetworkAttachmentPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"association_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attachment_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"connect",
+					"site-to-site-vpn",
+					"transit-gateway-route-table",
+					"vpc",
+				}, false),
+			},
+			"core_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"policy_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"require_acceptance": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rule_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"segment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCoreNetworkAttachmentPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn(ctx)
+
+	coreNetworkID := d.Get("core_network_id").(string)
+	policy, err := FindCoreNetworkPolicyByAlias(ctx, conn, coreNetworkID, networkmanager.CoreNetworkPolicyAliasLive)
+
+	if err != nil {
+		return diag.Errorf("reading Network Manager Core Network (%s) LIVE policy: %s", coreNetworkID, err)
+	}
+
+	encodedPolicyDocument, err := protocol.EncodeJSONValue(policy.PolicyDocument, protocol.NoEscape)
+
+	if err != nil {
+		return diag.Errorf("encoding Network Manager Core Network (%s) policy document: %s", coreNetworkID, err)
+	}
+
+	var doc CoreNetworkPolicyDoc
+
+	if err := json.Unmarshal([]byte(encodedPolicyDocument), &doc); err != nil {
+		return diag.Errorf("decoding Network Manager Core Network (%s) policy document: %s", coreNetworkID, err)
+	}
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+	region := meta.(*conns.AWSClient).Region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+
+	attachment := &coreNetworkPolicyAttachment{
+		AccountID:      accountID,
+		AttachmentType: d.Get("attachment_type").(string),
+		Region:         region,
+		ResourceID:     d.Get("resource_id").(string),
+		Tags:           flex.ExpandStringValueMap(d.Get("tags").(map[string]interface{})),
+	}
+
+	d.SetId(coreNetworkID)
+	d.Set("account_id", accountID)
+	d.Set("policy_version_id", policy.PolicyVersionId)
+	d.Set("region", region)
+
+	if v := attachment.match(doc.AttachmentPolicies); v != nil {
+		d.Set("association_method", v.Action.AssociationMethod)
+		d.Set("require_acceptance", v.Action.RequireAcceptance)
+		d.Set("rule_number", v.RuleNumber)
+		d.Set("segment", attachment.segment(v.Action))
+	} else {
+		d.Set("association_method", nil)
+		d.Set("require_acceptance", nil)
+		d.Set("rule_number", nil)
+		d.Set("segment", nil)
+	}
+
+	return nil
+}
+
+// coreNetworkPolicyAttachment holds the attachment properties that attachment policy conditions are evaluated against.
+type coreNetworkPolicyAttachment struct {
+	AccountID      string
+	AttachmentType string
+	Region         string
+	ResourceID     string
+	Tags           map[string]string
+}
+
+// match returns the attachment policy with the lowest rule number whose conditions match the attachment.
+func (a *coreNetworkPolicyAttachment) match(policies []*CoreNetworkAttachmentPolicy) *CoreNetworkAttachmentPolicy {
+	var sorted []*CoreNetworkAttachmentPolicy
+	for _, policy := range policies {
+		if policy != nil && policy.Action != nil && len(policy.Conditions) > 0 {
+			sorted = append(sorted, policy)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].RuleNumber < sorted[j].RuleNumber
+	})
+
+	for _, policy := range sorted {
+		or := policy.ConditionLogic == "or"
+		matched := !or
+
+		for _, condition := range policy.Conditions {
+			if condition == nil {
+				continue
+			}
+
+			if a.matchCondition(condition) == or {
+				matched = or
+				break
+			}
+		}
+
+		if matched {
+			return policy
+		}
+	}
+
+	return nil
+}
+
+func (a *coreNetworkPolicyAttachment) matchCondition(condition *CoreNetworkAttachmentPolicyCondition) bool {
+	var value string
+
+	switch condition.Type {
+	case "any":
+		return true
+	case "account-id":
+		value = a.AccountID
+	case "attachment-type":
+		value = a.AttachmentType
+	case "region":
+		value = a.Region
+	case "resource-id":
+		value = a.ResourceID
+	case "tag-exists":
+		_, ok := a.Tags[condition.Key]
+		return ok
+	case "tag-value":
+		v, ok := a.Tags[condition.Key]
+		if !ok {
+			return false
+		}
+		value = v
+	default:
+		return false
+	}
+
+	switch condition.Operator {
+	case "equals":
+		return value == condition.Value
+	case "not-equals":
+		return value != condition.Value
+	case "contains":
+		return strings.Contains(value, condition.Value)
+	case "begins-with":
+		return strings.HasPrefix(value, condition.Value)
+	default:
+		return false
+	}
+}
+
+// segment returns the name of the segment that the attachment policy action associates the attachment with.
+func (a *coreNetworkPolicyAttachment) segment(action *CoreNetworkAttachmentPolicyAction) string {
+	switch action.AssociationMethod {
+	case "constant":
+		return action.Segment
+	case "tag":
+		return a.Tags[action.TagValueOfKey]
+	default:
+		return ""
+	}
+}
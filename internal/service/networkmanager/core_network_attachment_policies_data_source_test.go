@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerCoreNetworkAttachmentPoliciesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceConstant := "data.aws_networkmanager_core_network_attachment_policies.constant"
+	dataSourceTag := "data.aws_networkmanager_core_network_attachment_policies.tag"
+	dataSourceNoMatch := "data.aws_networkmanager_core_network_attachment_policies.no_match"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkAttachmentPoliciesDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceConstant, "id", "aws_networkmanager_core_network.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceConstant, "association_method", "constant"),
+					resource.TestCheckResourceAttr(dataSourceConstant, "require_acceptance", "true"),
+					resource.TestCheckResourceAttr(dataSourceConstant, "rule_number", "100"),
+					resource.TestCheckResourceAttr(dataSourceConstant, "segment", "shared"),
+					resource.TestCheckResourceAttr(dataSourceTag, "association_method", "tag"),
+					resource.TestCheckResourceAttr(dataSourceTag, "require_acceptance", "false"),
+					resource.TestCheckResourceAttr(dataSourceTag, "rule_number", "200"),
+					resource.TestCheckResourceAttr(dataSourceTag, "segment", "development"),
+					resource.TestCheckResourceAttr(dataSourceNoMatch, "rule_number", "0"),
+					resource.TestCheckResourceAttr(dataSourceNoMatch, "segment", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCoreNetworkAttachmentPoliciesDataSourceConfig_basic() string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {}
+
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["65022-65534"]
+
+    edge_locations {
+      location = %[1]q
+    }
+  }
+
+  segments {
+    name = "shared"
+  }
+
+  segments {
+    name = "development"
+  }
+
+  attachment_policies {
+    rule_number = 100
+
+    conditions {
+      type     = "tag-value"
+      operator = "equals"
+      key      = "segment"
+      value    = "shared"
+    }
+
+    action {
+      association_method = "constant"
+      segment            = "shared"
+      require_acceptance = true
+    }
+  }
+
+  attachment_policies {
+    rule_number     = 200
+    condition_logic = "or"
+
+    conditions {
+      type = "tag-exists"
+      key  = "env"
+    }
+
+    conditions {
+      type     = "attachment-type"
+      operator = "equals"
+      value    = "connect"
+    }
+
+    action {
+      association_method = "tag"
+      tag_value_of_key   = "env"
+    }
+  }
+}
+
+resource "aws_networkmanager_core_network" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+resource "aws_networkmanager_core_network_policy_attachment" "test" {
+  core_network_id = aws_networkmanager_core_network.test.id
+  policy_document = data.aws_networkmanager_core_network_policy_document.test.json
+}
+
+data "aws_networkmanager_core_network_attachment_policies" "constant" {
+  core_network_id = aws_networkmanager_core_network_policy_attachment.test.core_network_id
+  attachment_type = "vpc"
+
+  tags = {
+    segment = "shared"
+    env     = "development"
+  }
+}
+
+data "aws_networkmanager_core_network_attachment_policies" "tag" {
+  core_network_id = aws_networkmanager_core_network_policy_attachment.test.core_network_id
+  attachment_type = "vpc"
+
+  tags = {
+    env = "development"
+  }
+}
+
+data "aws_networkmanager_core_network_attachment_policies" "no_match" {
+  core_network_id = aws_networkmanager_core_network_policy_attachment.test.core_network_id
+  attachment_type = "vpc"
+}
+`, acctest.Region())
+}
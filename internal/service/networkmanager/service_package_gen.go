@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceConnections,
 			TypeName: "aws_networkmanager_connections",
 		},
+		{
+			Factory:  DataSourceCoreNetworkAttachmentPolicies,
+			TypeName: "aws_networkmanager_core_network_attachment_policies",
+		},
 		{
 			Factory:  DataSourceCoreNetworkPolicyDocument,
 			TypeName: "aws_networkmanager_core_network_policy_document",
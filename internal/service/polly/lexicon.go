@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package polly
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/polly"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_polly_lexicon", name="Lexicon")
+func ResourceLexicon() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLexiconPut,
+		ReadWithoutTimeout:   resourceLexiconRead,
+		UpdateWithoutTimeout: resourceLexiconPut,
+		DeleteWithoutTimeout: resourceLexiconDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alphabet": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 40000),
+			},
+			"language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lexemes_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z]{1,20}$`), "must contain only alphanumeric characters and be at most 20 characters long"),
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLexiconPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PollyConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &polly.PutLexiconInput{
+		Content: aws.String(d.Get("content").(string)),
+		Name:    aws.String(name),
+	}
+
+	if _, err := conn.PutLexiconWithContext(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Polly Lexicon (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return append(diags, resourceLexiconRead(ctx, d, meta)...)
+}
+
+func resourceLexiconRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PollyConn(ctx)
+
+	output, err := FindLexiconByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Polly Lexicon (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Polly Lexicon (%s): %s", d.Id(), err)
+	}
+
+	d.Set("content", output.Lexicon.Content)
+	d.Set("name", output.Lexicon.Name)
+
+	if v := output.LexiconAttributes; v != nil {
+		d.Set("alphabet", v.Alphabet)
+		d.Set("arn", v.LexiconArn)
+		d.Set("language_code", v.LanguageCode)
+		d.Set("lexemes_count", v.LexemesCount)
+		d.Set("size", v.Size)
+	}
+
+	return diags
+}
+
+func resourceLexiconDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PollyConn(ctx)
+
+	log.Printf("[INFO] Deleting Polly Lexicon: %s", d.Id())
+	_, err := conn.DeleteLexiconWithContext(ctx, &polly.DeleteLexiconInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, polly.ErrCodeLexiconNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Polly Lexicon (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindLexiconByName(ctx context.Context, conn *polly.Polly, name string) (*polly.GetLexiconOutput, error) {
+	input := &polly.GetLexiconInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetLexiconWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, polly.ErrCodeLexiconNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Lexicon == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
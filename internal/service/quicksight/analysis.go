@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -129,6 +130,33 @@ func ResourceAnalysis() *schema.Resource {
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				"template_snapshot": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"template_id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 512),
+							},
+							"version_description": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 512),
+							},
+						},
+					},
+				},
+				"template_snapshot_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"template_snapshot_version_number": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
 				"theme_arn": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -136,7 +164,13 @@ func ResourceAnalysis() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			// Snapshot the analysis, including any changes made outside of Terraform, on every apply.
+			customdiff.ComputedIf("template_snapshot_version_number", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return len(diff.Get("template_snapshot").([]interface{})) > 0
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -183,10 +217,17 @@ func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAnalysis, d.Get("name").(string), err)
 	}
 
-	if _, err := waitAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	out, err := waitAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
 		return create.DiagError(names.QuickSight, create.ErrActionWaitingForCreation, ResNameAnalysis, d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("template_snapshot"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := snapshotAnalysisToTemplate(ctx, d, conn, awsAccountId, analysisId, aws.StringValue(out.Arn), v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionCreating, ResNameAnalysis, d.Id(), err)
+		}
+	}
+
 	return resourceAnalysisRead(ctx, d, meta)
 }
 
@@ -262,7 +303,7 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.FromErr(err)
 	}
 
-	if d.HasChangesExcept("permissions", "tags", "tags_all") {
+	if d.HasChangesExcept("permissions", "tags", "tags_all", "template_snapshot", "template_snapshot_arn", "template_snapshot_version_number") {
 		in := &quicksight.UpdateAnalysisInput{
 			AwsAccountId: aws.String(awsAccountId),
 			AnalysisId:   aws.String(analysisId),
@@ -291,6 +332,14 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// Snapshot after updating so that the template matches the applied analysis.
+	// Changes made outside of Terraform are captured if they weren't overwritten (e.g. with definition in ignore_changes).
+	if v, ok := d.GetOk("template_snapshot"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := snapshotAnalysisToTemplate(ctx, d, conn, awsAccountId, analysisId, d.Get("arn").(string), v.([]interface{})[0].(map[string]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionUpdating, ResNameAnalysis, d.Id(), err)
+		}
+	}
+
 	if d.HasChange("permissions") {
 		oraw, nraw := d.GetChange("permissions")
 		o := oraw.(*schema.Set)
@@ -355,6 +404,93 @@ func resourceAnalysisDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
+// snapshotAnalysisToTemplate creates or updates a template from the current state of an analysis.
+// Data set placeholders are named after the analysis' data set identifiers.
+// An existing template is only updated if its current version was created from the same analysis,
+// so that unrelated templates are never overwritten.
+func snapshotAnalysisToTemplate(ctx context.Context, d *schema.ResourceData, conn *quicksight.QuickSight, awsAccountId, analysisId, analysisArn string, tfMap map[string]interface{}, timeout time.Duration) error {
+	descResp, err := conn.DescribeAnalysisDefinitionWithContext(ctx, &quicksight.DescribeAnalysisDefinitionInput{
+		AwsAccountId: aws.String(awsAccountId),
+		AnalysisId:   aws.String(analysisId),
+	})
+
+	if err != nil {
+		return fmt.Errorf("describing QuickSight Analysis (%s) Definition: %w", d.Id(), err)
+	}
+
+	var dataSetReferences []*quicksight.DataSetReference
+	if descResp.Definition != nil {
+		for _, v := range descResp.Definition.DataSetIdentifierDeclarations {
+			dataSetReferences = append(dataSetReferences, &quicksight.DataSetReference{
+				DataSetArn:         v.DataSetArn,
+				DataSetPlaceholder: v.Identifier,
+			})
+		}
+	}
+
+	templateId := tfMap["template_id"].(string)
+	id := createTemplateId(awsAccountId, templateId)
+	sourceEntity := &quicksight.TemplateSourceEntity{
+		SourceAnalysis: &quicksight.TemplateSourceAnalysis{
+			Arn:               aws.String(analysisArn),
+			DataSetReferences: dataSetReferences,
+		},
+	}
+
+	var versionDescription *string
+	if v, ok := tfMap["version_description"].(string); ok && v != "" {
+		versionDescription = aws.String(v)
+	}
+
+	template, err := FindTemplateByID(ctx, conn, id)
+
+	switch {
+	case tfresource.NotFound(err):
+		input := &quicksight.CreateTemplateInput{
+			AwsAccountId:       aws.String(awsAccountId),
+			Name:               aws.String(d.Get("name").(string)),
+			SourceEntity:       sourceEntity,
+			TemplateId:         aws.String(templateId),
+			VersionDescription: versionDescription,
+		}
+
+		if _, err := conn.CreateTemplateWithContext(ctx, input); err != nil {
+			return fmt.Errorf("creating QuickSight Template (%s): %w", id, err)
+		}
+
+		template, err = waitTemplateCreated(ctx, conn, id, timeout)
+		if err != nil {
+			return fmt.Errorf("waiting for QuickSight Template (%s) create: %w", id, err)
+		}
+	case err != nil:
+		return fmt.Errorf("reading QuickSight Template (%s): %w", id, err)
+	case template.Version == nil || aws.StringValue(template.Version.SourceEntityArn) != analysisArn:
+		return fmt.Errorf("QuickSight Template (%s) already exists and was not created from this analysis; choose a different template_id", id)
+	default:
+		input := &quicksight.UpdateTemplateInput{
+			AwsAccountId:       aws.String(awsAccountId),
+			Name:               aws.String(d.Get("name").(string)),
+			SourceEntity:       sourceEntity,
+			TemplateId:         aws.String(templateId),
+			VersionDescription: versionDescription,
+		}
+
+		if _, err := conn.UpdateTemplateWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating QuickSight Template (%s): %w", id, err)
+		}
+
+		template, err = waitTemplateUpdated(ctx, conn, id, timeout)
+		if err != nil {
+			return fmt.Errorf("waiting for QuickSight Template (%s) update: %w", id, err)
+		}
+	}
+
+	d.Set("template_snapshot_arn", template.Arn)
+	d.Set("template_snapshot_version_number", template.Version.VersionNumber)
+
+	return nil
+}
+
 func FindAnalysisByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.Analysis, error) {
 	awsAccountId, analysisId, err := ParseAnalysisId(id)
 	if err != nil {
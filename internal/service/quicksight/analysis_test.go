@@ -271,6 +271,50 @@ func TestAccQuickSightAnalysis_Definition_calculatedFields(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightAnalysis_templateSnapshot(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var analysis quicksight.Analysis
+	resourceName := "aws_quicksight_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckAnalysisDestroy(ctx, false),
+			testAccCheckAnalysisTemplateSnapshotDestroy(ctx),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfig_templateSnapshot(rId, rName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "template_snapshot.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_snapshot.0.template_id", rId),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "template_snapshot_arn", "quicksight", fmt.Sprintf("template/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "template_snapshot_version_number", "1"),
+				),
+				// The template is snapshotted on every apply.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAnalysisConfig_templateSnapshot(rId, rName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnalysisExists(ctx, resourceName, &analysis),
+					resource.TestCheckResourceAttr(resourceName, "template_snapshot.0.version_description", "v2"),
+					resource.TestCheckResourceAttr(resourceName, "template_snapshot_version_number", "2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAnalysisDestroy(ctx context.Context, forceDelete bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
@@ -297,6 +341,34 @@ func testAccCheckAnalysisDestroy(ctx context.Context, forceDelete bool) resource
 	}
 }
 
+// testAccCheckAnalysisTemplateSnapshotDestroy deletes any template snapshot, which isn't deleted with the analysis.
+func testAccCheckAnalysisTemplateSnapshotDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_analysis" {
+				continue
+			}
+
+			_, err := conn.DeleteTemplateWithContext(ctx, &quicksight.DeleteTemplateInput{
+				AwsAccountId: aws.String(rs.Primary.Attributes["aws_account_id"]),
+				TemplateId:   aws.String(rs.Primary.Attributes["template_snapshot.0.template_id"]),
+			})
+
+			if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAnalysisExists(ctx context.Context, name string, analysis *quicksight.Analysis) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -435,6 +507,44 @@ resource "aws_quicksight_analysis" "test" {
 `, rId, rName))
 }
 
+func testAccAnalysisConfig_templateSnapshot(rId, rName, versionDescription string) string {
+	return acctest.ConfigCompose(
+		testAccAnalysisConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_analysis" "test" {
+  analysis_id = %[1]q
+  name        = %[2]q
+
+  definition {
+    data_set_identifiers_declarations {
+      data_set_arn = aws_quicksight_data_set.test.arn
+      identifier   = "1"
+    }
+    sheets {
+      title    = "Test"
+      sheet_id = "Test1"
+      visuals {
+        custom_content_visual {
+          data_set_identifier = "1"
+          title {
+            format_text {
+              plain_text = "Test"
+            }
+          }
+          visual_id = "Test1"
+        }
+      }
+    }
+  }
+
+  template_snapshot {
+    template_id         = %[1]q
+    version_description = %[3]q
+  }
+}
+`, rId, rName, versionDescription))
+}
+
 func testAccAnalysisConfig_TemplateSourceEntity(rId, rName, sourceId, sourceName string) string {
 	return acctest.ConfigCompose(
 		testAccAnalysisConfigBase(rId, rName),
@@ -8,14 +8,17 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -30,7 +33,11 @@ import (
 
 // @FrameworkResource(name="Ingestion")
 func newResourceIngestion(_ context.Context) (resource.ResourceWithConfigure, error) {
-	return &resourceIngestion{}, nil
+	r := &resourceIngestion{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
 }
 
 const (
@@ -39,6 +46,7 @@ const (
 
 type resourceIngestion struct {
 	framework.ResourceWithConfigure
+	framework.WithTimeouts
 }
 
 func (r *resourceIngestion) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -84,6 +92,16 @@ func (r *resourceIngestion) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
@@ -127,6 +145,23 @@ func (r *resourceIngestion) Create(ctx context.Context, req resource.CreateReque
 	plan.ARN = flex.StringToFramework(ctx, out.Arn)
 	plan.IngestionStatus = flex.StringToFramework(ctx, out.IngestionStatus)
 
+	if plan.WaitForCompletion.ValueBool() {
+		ingestion, err := waitIngestionCompleted(ctx, conn, plan.ID.ValueString(), r.CreateTimeout(ctx, plan.Timeouts))
+		if err != nil {
+			// The ingestion exists, so save it to state. It's marked as tainted and replaced on the next apply.
+			if ingestion != nil {
+				plan.IngestionStatus = flex.StringToFramework(ctx, ingestion.IngestionStatus)
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QuickSight, create.ErrActionWaitingForCreation, ResNameIngestion, plan.IngestionID.String(), nil),
+				err.Error(),
+			)
+			return
+		}
+		plan.IngestionStatus = flex.StringToFramework(ctx, ingestion.IngestionStatus)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -168,6 +203,9 @@ func (r *resourceIngestion) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	state.AWSAccountID = flex.StringValueToFramework(ctx, awsAccountID)
 	state.DataSetID = flex.StringValueToFramework(ctx, dataSetID)
+	if state.WaitForCompletion.IsNull() {
+		state.WaitForCompletion = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -249,11 +287,13 @@ func createIngestionID(awsAccountID, dataSetID, ingestionID string) string {
 }
 
 type resourceIngestionData struct {
-	ARN             types.String `tfsdk:"arn"`
-	AWSAccountID    types.String `tfsdk:"aws_account_id"`
-	DataSetID       types.String `tfsdk:"data_set_id"`
-	ID              types.String `tfsdk:"id"`
-	IngestionID     types.String `tfsdk:"ingestion_id"`
-	IngestionStatus types.String `tfsdk:"ingestion_status"`
-	IngestionType   types.String `tfsdk:"ingestion_type"`
+	ARN               types.String   `tfsdk:"arn"`
+	AWSAccountID      types.String   `tfsdk:"aws_account_id"`
+	DataSetID         types.String   `tfsdk:"data_set_id"`
+	ID                types.String   `tfsdk:"id"`
+	IngestionID       types.String   `tfsdk:"ingestion_id"`
+	IngestionStatus   types.String   `tfsdk:"ingestion_status"`
+	IngestionType     types.String   `tfsdk:"ingestion_type"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
 }
@@ -57,6 +57,40 @@ func TestAccQuickSightIngestion_basic(t *testing.T) {
 	})
 }
 
+func TestAccQuickSightIngestion_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ingestion quicksight.Ingestion
+	resourceName := "aws_quicksight_ingestion.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIngestionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionConfig_waitForCompletion(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIngestionExists(ctx, resourceName, &ingestion),
+					resource.TestCheckResourceAttr(resourceName, "ingestion_status", quicksight.IngestionStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ingestion_type",
+					"wait_for_completion",
+				},
+			},
+		},
+	})
+}
+
 // NOTE: There is no base _disappears test for this resource. Ingestions
 // persist for the life of the parent data set, even if cancelled, so
 // disappearance of this upstream resource is tested instead.
@@ -181,3 +215,16 @@ resource "aws_quicksight_ingestion" "test" {
 }
 `, rId, rName, ingestionType))
 }
+
+func testAccIngestionConfig_waitForCompletion(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccIngestionConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_ingestion" "test" {
+  data_set_id         = aws_quicksight_data_set.test.data_set_id
+  ingestion_id        = %[1]q
+  ingestion_type      = "FULL_REFRESH"
+  wait_for_completion = true
+}
+`, rId))
+}
@@ -70,6 +70,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_quicksight_group",
 			Name:     "Group",
 		},
+		{
+			Factory:  DataSourceSPICECapacity,
+			TypeName: "aws_quicksight_spice_capacity",
+			Name:     "SPICE Capacity",
+		},
 		{
 			Factory:  DataSourceTheme,
 			TypeName: "aws_quicksight_theme",
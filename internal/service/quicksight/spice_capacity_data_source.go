@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_quicksight_spice_capacity", name="SPICE Capacity")
+func DataSourceSPICECapacity() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSPICECapacityRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"aws_account_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				"data_set_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"data_sets": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"arn": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"consumed_spice_capacity_in_bytes": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"data_set_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"total_consumed_spice_capacity_in_bytes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+const (
+	DSNameSPICECapacity = "SPICE Capacity Data Source"
+)
+
+func dataSourceSPICECapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+
+	var dataSetIds []string
+	// Data sets listed explicitly must be describable; when discovering data sets,
+	// those that the API can't describe (e.g. file uploads) are skipped.
+	explicit := false
+
+	if v, ok := d.GetOk("data_set_ids"); ok && v.(*schema.Set).Len() > 0 {
+		dataSetIds = flex.ExpandStringValueSet(v.(*schema.Set))
+		explicit = true
+	} else {
+		input := &quicksight.ListDataSetsInput{
+			AwsAccountId: aws.String(awsAccountId),
+		}
+
+		err := conn.ListDataSetsPagesWithContext(ctx, input, func(page *quicksight.ListDataSetsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.DataSetSummaries {
+				if v == nil || aws.StringValue(v.ImportMode) != quicksight.DataSetImportModeSpice {
+					continue
+				}
+
+				dataSetIds = append(dataSetIds, aws.StringValue(v.DataSetId))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionReading, DSNameSPICECapacity, awsAccountId, err)
+		}
+	}
+
+	var tfList []interface{}
+	var total int64
+
+	for _, dataSetId := range dataSetIds {
+		output, err := conn.DescribeDataSetWithContext(ctx, &quicksight.DescribeDataSetInput{
+			AwsAccountId: aws.String(awsAccountId),
+			DataSetId:    aws.String(dataSetId),
+		})
+
+		if !explicit && tfawserr.ErrCodeEquals(err, quicksight.ErrCodeInvalidParameterValueException) {
+			log.Printf("[WARN] skipping QuickSight Data Set (%s): %s", dataSetId, err)
+			continue
+		}
+
+		if err != nil {
+			return create.DiagError(names.QuickSight, create.ErrActionReading, DSNameSPICECapacity, dataSetId, err)
+		}
+
+		if output == nil || output.DataSet == nil {
+			continue
+		}
+
+		dataSet := output.DataSet
+		consumed := aws.Int64Value(dataSet.ConsumedSpiceCapacityInBytes)
+		total += consumed
+
+		tfList = append(tfList, map[string]interface{}{
+			"arn":                              aws.StringValue(dataSet.Arn),
+			"consumed_spice_capacity_in_bytes": consumed,
+			"data_set_id":                      aws.StringValue(dataSet.DataSetId),
+			"name":                             aws.StringValue(dataSet.Name),
+		})
+	}
+
+	d.SetId(awsAccountId)
+	d.Set("aws_account_id", awsAccountId)
+	if err := d.Set("data_sets", tfList); err != nil {
+		return diag.Errorf("setting data_sets: %s", err)
+	}
+	d.Set("total_consumed_spice_capacity_in_bytes", total)
+
+	return nil
+}
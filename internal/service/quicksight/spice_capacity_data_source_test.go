@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package quicksight_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccQuickSightSPICECapacityDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_data_set.test"
+	dataSourceName := "data.aws_quicksight_spice_capacity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSPICECapacityDataSourceConfig_basic(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, "aws_account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "data_sets.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_sets.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_sets.0.data_set_id", resourceName, "data_set_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_sets.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "data_sets.0.consumed_spice_capacity_in_bytes"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "total_consumed_spice_capacity_in_bytes", dataSourceName, "data_sets.0.consumed_spice_capacity_in_bytes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSPICECapacityDataSourceConfig_basic(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccIngestionConfigBase(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_ingestion" "test" {
+  data_set_id         = aws_quicksight_data_set.test.data_set_id
+  ingestion_id        = %[1]q
+  ingestion_type      = "FULL_REFRESH"
+  wait_for_completion = true
+}
+
+data "aws_quicksight_spice_capacity" "test" {
+  data_set_ids = [aws_quicksight_ingestion.test.data_set_id]
+}
+`, rId))
+}
@@ -97,3 +97,19 @@ func statusTheme(ctx context.Context, conn *quicksight.QuickSight, id string) re
 		return out, *out.Version.Status, nil
 	}
 }
+
+// Fetch Ingestion status
+func statusIngestion(ctx context.Context, conn *quicksight.QuickSight, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindIngestionByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.IngestionStatus), nil
+	}
+}
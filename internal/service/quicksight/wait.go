@@ -305,3 +305,23 @@ func waitThemeUpdated(ctx context.Context, conn *quicksight.QuickSight, id strin
 
 	return nil, err
 }
+
+func waitIngestionCompleted(ctx context.Context, conn *quicksight.QuickSight, id string, timeout time.Duration) (*quicksight.Ingestion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{quicksight.IngestionStatusInitialized, quicksight.IngestionStatusQueued, quicksight.IngestionStatusRunning},
+		Target:  []string{quicksight.IngestionStatusCompleted},
+		Refresh: statusIngestion(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*quicksight.Ingestion); ok {
+		if errorInfo := out.ErrorInfo; errorInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(errorInfo.Type), aws.StringValue(errorInfo.Message)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
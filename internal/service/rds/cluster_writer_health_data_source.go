@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_rds_cluster_writer_health")
+func DataSourceClusterWriterHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceClusterWriterHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"writer_instance_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"writer_instance_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterWriterHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+
+	dbClusterID := d.Get("cluster_identifier").(string)
+	dbc, err := FindDBClusterByID(ctx, conn, dbClusterID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster (%s): %s", dbClusterID, err)
+	}
+
+	d.SetId(aws.StringValue(dbc.DBClusterIdentifier))
+	d.Set("cluster_identifier", dbc.DBClusterIdentifier)
+	d.Set("cluster_status", dbc.Status)
+
+	var writerID, writerStatus string
+
+	for _, v := range dbc.DBClusterMembers {
+		if aws.BoolValue(v.IsClusterWriter) {
+			writerID = aws.StringValue(v.DBInstanceIdentifier)
+			break
+		}
+	}
+
+	if writerID != "" {
+		dbi, err := findDBInstanceByIDSDKv1(ctx, conn, writerID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS DB Instance (%s): %s", writerID, err)
+		}
+
+		writerStatus = aws.StringValue(dbi.DBInstanceStatus)
+	}
+
+	d.Set("healthy", aws.StringValue(dbc.Status) == ClusterStatusAvailable && writerStatus == InstanceStatusAvailable)
+	d.Set("writer_instance_identifier", writerID)
+	d.Set("writer_instance_status", writerStatus)
+
+	return diags
+}
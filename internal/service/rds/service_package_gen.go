@@ -73,6 +73,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCluster,
 			TypeName: "aws_rds_cluster",
 		},
+		{
+			Factory:  DataSourceClusterWriterHealth,
+			TypeName: "aws_rds_cluster_writer_health",
+		},
 		{
 			Factory:  DataSourceClusters,
 			TypeName: "aws_rds_clusters",
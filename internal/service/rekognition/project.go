@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_rekognition_project", name="Project")
+func ResourceProject() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectCreate,
+		ReadWithoutTimeout:   resourceProjectRead,
+		DeleteWithoutTimeout: resourceProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters, underscores, hyphens and periods"),
+				),
+			},
+		},
+	}
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &rekognition.CreateProjectInput{
+		ProjectName: aws.String(name),
+	}
+
+	_, err := conn.CreateProjectWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Rekognition Project (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitProjectCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Project (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProjectRead(ctx, d, meta)...)
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	project, err := FindProjectByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Project (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", project.ProjectArn)
+	d.Set("name", d.Id())
+
+	return diags
+}
+
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	log.Printf("[INFO] Deleting Rekognition Project: %s", d.Id())
+	_, err := conn.DeleteProjectWithContext(ctx, &rekognition.DeleteProjectInput{
+		ProjectArn: aws.String(d.Get("arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Rekognition Project (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProjectDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Project (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindProjectByName(ctx context.Context, conn *rekognition.Rekognition, name string) (*rekognition.ProjectDescription, error) {
+	input := &rekognition.DescribeProjectsInput{
+		ProjectNames: aws.StringSlice([]string{name}),
+	}
+	var output []*rekognition.ProjectDescription
+
+	err := conn.DescribeProjectsPagesWithContext(ctx, input, func(page *rekognition.DescribeProjectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProjectDescriptions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func statusProject(ctx context.Context, conn *rekognition.Rekognition, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProjectByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitProjectCreated(ctx context.Context, conn *rekognition.Rekognition, name string, timeout time.Duration) (*rekognition.ProjectDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{rekognition.ProjectStatusCreating},
+		Target:  []string{rekognition.ProjectStatusCreated},
+		Refresh: statusProject(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectDeleted(ctx context.Context, conn *rekognition.Rekognition, name string, timeout time.Duration) (*rekognition.ProjectDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{rekognition.ProjectStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProject(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_rekognition_project_policy", name="Project Policy")
+func ResourceProjectPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectPolicyPut,
+		ReadWithoutTimeout:   resourceProjectPolicyRead,
+		UpdateWithoutTimeout: resourceProjectPolicyPut,
+		DeleteWithoutTimeout: resourceProjectPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters, underscores, hyphens and periods"),
+				),
+			},
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	projectPolicyResourceIDPartCount = 2
+)
+
+func resourceProjectPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "policy (%s) is invalid JSON: %s", d.Get("policy").(string), err)
+	}
+
+	projectARN, policyName := d.Get("project_arn").(string), d.Get("policy_name").(string)
+	id, err := flex.FlattenResourceId([]string{projectARN, policyName}, projectPolicyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &rekognition.PutProjectPolicyInput{
+		PolicyDocument: aws.String(policy),
+		PolicyName:     aws.String(policyName),
+		ProjectArn:     aws.String(projectARN),
+	}
+
+	// The current revision must be supplied to replace an existing policy.
+	if v, ok := d.GetOk("revision_id"); ok {
+		input.PolicyRevisionId = aws.String(v.(string))
+	}
+
+	_, err = conn.PutProjectPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Rekognition Project Policy (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceProjectPolicyRead(ctx, d, meta)...)
+}
+
+func resourceProjectPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), projectPolicyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	projectARN, policyName := parts[0], parts[1]
+	projectPolicy, err := FindProjectPolicyByTwoPartKey(ctx, conn, projectARN, policyName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Project Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project Policy (%s): %s", d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(projectPolicy.PolicyDocument))
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("policy", policyToSet)
+	d.Set("policy_name", projectPolicy.PolicyName)
+	d.Set("project_arn", projectPolicy.ProjectArn)
+	d.Set("revision_id", projectPolicy.PolicyRevisionId)
+
+	return diags
+}
+
+func resourceProjectPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), projectPolicyResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Rekognition Project Policy: %s", d.Id())
+	_, err = conn.DeleteProjectPolicyWithContext(ctx, &rekognition.DeleteProjectPolicyInput{
+		PolicyName: aws.String(parts[1]),
+		ProjectArn: aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Rekognition Project Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindProjectPolicyByTwoPartKey(ctx context.Context, conn *rekognition.Rekognition, projectARN, policyName string) (*rekognition.ProjectPolicy, error) {
+	input := &rekognition.ListProjectPoliciesInput{
+		ProjectArn: aws.String(projectARN),
+	}
+	var output []*rekognition.ProjectPolicy
+
+	err := conn.ListProjectPoliciesPagesWithContext(ctx, input, func(page *rekognition.ListProjectPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProjectPolicies {
+			if v != nil && aws.StringValue(v.PolicyName) == policyName {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
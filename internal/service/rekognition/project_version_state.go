@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_rekognition_project_version_state", name="Project Version State")
+func ResourceProjectVersionState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectVersionStateCreate,
+		ReadWithoutTimeout:   resourceProjectVersionStateRead,
+		UpdateWithoutTimeout: resourceProjectVersionStateUpdate,
+		DeleteWithoutTimeout: resourceProjectVersionStateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"max_inference_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_inference_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"project_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{rekognition.ProjectVersionStatusRunning, rekognition.ProjectVersionStatusStopped}, false),
+			},
+			"version_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	projectVersionStateResourceIDPartCount = 2
+)
+
+func resourceProjectVersionStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	projectARN, versionName := d.Get("project_arn").(string), d.Get("version_name").(string)
+	id, err := flex.FlattenResourceId([]string{projectARN, versionName}, projectVersionStateResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	projectVersion, err := FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if err := updateProjectVersionState(ctx, conn, d, projectVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Rekognition Project Version State (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProjectVersionStateRead(ctx, d, meta)...)
+}
+
+func resourceProjectVersionStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), projectVersionStateResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	projectVersion, err := FindProjectVersionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Project Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("max_inference_units", projectVersion.MaxInferenceUnits)
+	d.Set("project_arn", parts[0])
+	d.Set("project_version_arn", projectVersion.ProjectVersionArn)
+	d.Set("version_name", parts[1])
+
+	// Only a running model reports the inference units it was started with.
+	if status := aws.StringValue(projectVersion.Status); status == rekognition.ProjectVersionStatusRunning {
+		d.Set("min_inference_units", projectVersion.MinInferenceUnits)
+		d.Set("state", status)
+	} else {
+		d.Set("state", rekognition.ProjectVersionStatusStopped)
+	}
+
+	return diags
+}
+
+func resourceProjectVersionStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), projectVersionStateResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	projectVersion, err := FindProjectVersionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	if err := updateProjectVersionState(ctx, conn, d, projectVersion, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Rekognition Project Version State (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProjectVersionStateRead(ctx, d, meta)...)
+}
+
+func resourceProjectVersionStateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] %s deleting an aws_rekognition_project_version_state resource only stops managing the model state, the model is left in its current state.", d.Id())
+
+	return nil
+}
+
+// updateProjectVersionState starts or stops the model so that it matches the configured state.
+// A running model whose inference units have changed is stopped and started again.
+func updateProjectVersionState(ctx context.Context, conn *rekognition.Rekognition, d *schema.ResourceData, projectVersion *rekognition.ProjectVersionDescription, timeout time.Duration) error {
+	projectVersionARN := aws.StringValue(projectVersion.ProjectVersionArn)
+	currentState, targetState := aws.StringValue(projectVersion.Status), d.Get("state").(string)
+
+	minInferenceUnits, maxInferenceUnits := int64(d.Get("min_inference_units").(int)), int64(d.Get("max_inference_units").(int))
+	inferenceUnitsChanged := aws.Int64Value(projectVersion.MinInferenceUnits) != minInferenceUnits || (maxInferenceUnits > 0 && aws.Int64Value(projectVersion.MaxInferenceUnits) != maxInferenceUnits)
+
+	if currentState == rekognition.ProjectVersionStatusRunning && (targetState == rekognition.ProjectVersionStatusStopped || inferenceUnitsChanged) {
+		if _, err := conn.StopProjectVersionWithContext(ctx, &rekognition.StopProjectVersionInput{
+			ProjectVersionArn: aws.String(projectVersionARN),
+		}); err != nil {
+			return err
+		}
+
+		if _, err := waitProjectVersionStopped(ctx, conn, d.Get("project_arn").(string), d.Get("version_name").(string), timeout); err != nil {
+			return err
+		}
+
+		currentState = rekognition.ProjectVersionStatusStopped
+	}
+
+	if currentState != rekognition.ProjectVersionStatusRunning && targetState == rekognition.ProjectVersionStatusRunning {
+		input := &rekognition.StartProjectVersionInput{
+			MinInferenceUnits: aws.Int64(minInferenceUnits),
+			ProjectVersionArn: aws.String(projectVersionARN),
+		}
+
+		if maxInferenceUnits > 0 {
+			input.MaxInferenceUnits = aws.Int64(maxInferenceUnits)
+		}
+
+		if _, err := conn.StartProjectVersionWithContext(ctx, input); err != nil {
+			return err
+		}
+
+		if _, err := waitProjectVersionRunning(ctx, conn, d.Get("project_arn").(string), d.Get("version_name").(string), timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func FindProjectVersionByTwoPartKey(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string) (*rekognition.ProjectVersionDescription, error) {
+	input := &rekognition.DescribeProjectVersionsInput{
+		ProjectArn:   aws.String(projectARN),
+		VersionNames: aws.StringSlice([]string{versionName}),
+	}
+	var output []*rekognition.ProjectVersionDescription
+
+	err := conn.DescribeProjectVersionsPagesWithContext(ctx, input, func(page *rekognition.DescribeProjectVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProjectVersionDescriptions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func statusProjectVersion(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitProjectVersionRunning(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusStarting},
+		Target:  []string{rekognition.ProjectVersionStatusRunning},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionStopped(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusStopping},
+		Target:  []string{rekognition.ProjectVersionStatusStopped},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+)
+
+// Training a Custom Labels model needs a labelled image dataset and takes hours,
+// so these tests use an existing trained model. The model should be stopped beforehand;
+// each test leaves it stopped. The tests share the model, so they don't run in parallel.
+const (
+	envVarProjectARN         = "AWS_REKOGNITION_PROJECT_ARN"
+	envVarProjectVersionName = "AWS_REKOGNITION_PROJECT_VERSION_NAME"
+	envVarProjectARNMessage  = "Environment variable AWS_REKOGNITION_PROJECT_ARN is not set. " +
+		"To run this test, set it to the ARN of a Rekognition Custom Labels project containing a trained model."
+	envVarProjectVersionNameMessage = "Environment variable AWS_REKOGNITION_PROJECT_VERSION_NAME is not set. " +
+		"To run this test, set it to the name of a trained model version in the AWS_REKOGNITION_PROJECT_ARN project."
+)
+
+func TestAccRekognitionProjectVersionState_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	projectARN := envvar.SkipIfEmpty(t, envVarProjectARN, envVarProjectARNMessage)
+	versionName := envvar.SkipIfEmpty(t, envVarProjectVersionName, envVarProjectVersionNameMessage)
+	resourceName := "aws_rekognition_project_version_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, rekognition.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionStateConfig_basic(projectARN, versionName, rekognition.ProjectVersionStatusRunning),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionStatus(ctx, resourceName, rekognition.ProjectVersionStatusRunning),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "project_arn", projectARN),
+					resource.TestCheckResourceAttrSet(resourceName, "project_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "state", rekognition.ProjectVersionStatusRunning),
+					resource.TestCheckResourceAttr(resourceName, "version_name", versionName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectVersionStateConfig_basic(projectARN, versionName, rekognition.ProjectVersionStatusStopped),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionStatus(ctx, resourceName, rekognition.ProjectVersionStatusStopped),
+					resource.TestCheckResourceAttr(resourceName, "state", rekognition.ProjectVersionStatusStopped),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRekognitionProjectVersionState_inferenceUnits(t *testing.T) {
+	ctx := acctest.Context(t)
+	projectARN := envvar.SkipIfEmpty(t, envVarProjectARN, envVarProjectARNMessage)
+	versionName := envvar.SkipIfEmpty(t, envVarProjectVersionName, envVarProjectVersionNameMessage)
+	resourceName := "aws_rekognition_project_version_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, rekognition.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionStateConfig_inferenceUnits(projectARN, versionName, rekognition.ProjectVersionStatusRunning, 1, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionStatus(ctx, resourceName, rekognition.ProjectVersionStatusRunning),
+					resource.TestCheckResourceAttr(resourceName, "max_inference_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "1"),
+				),
+			},
+			{
+				// Changing the inference units restarts the running model.
+				Config: testAccProjectVersionStateConfig_inferenceUnits(projectARN, versionName, rekognition.ProjectVersionStatusRunning, 1, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionStatus(ctx, resourceName, rekognition.ProjectVersionStatusRunning),
+					resource.TestCheckResourceAttr(resourceName, "max_inference_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_inference_units", "1"),
+				),
+			},
+			{
+				Config: testAccProjectVersionStateConfig_inferenceUnits(projectARN, versionName, rekognition.ProjectVersionStatusStopped, 1, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProjectVersionStatus(ctx, resourceName, rekognition.ProjectVersionStatusStopped),
+					resource.TestCheckResourceAttr(resourceName, "state", rekognition.ProjectVersionStatusStopped),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectVersionStatus(ctx context.Context, n, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn(ctx)
+
+		output, err := tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["project_arn"], rs.Primary.Attributes["version_name"])
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.Status); got != status {
+			return fmt.Errorf("Rekognition Project Version (%s) status is %s, want %s", rs.Primary.ID, got, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectVersionStateConfig_basic(projectARN, versionName, state string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project_version_state" "test" {
+  project_arn  = %[1]q
+  version_name = %[2]q
+  state        = %[3]q
+}
+`, projectARN, versionName, state)
+}
+
+func testAccProjectVersionStateConfig_inferenceUnits(projectARN, versionName, state string, minInferenceUnits, maxInferenceUnits int) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project_version_state" "test" {
+  project_arn         = %[1]q
+  version_name        = %[2]q
+  state               = %[3]q
+  min_inference_units = %[4]d
+  max_inference_units = %[5]d
+}
+`, projectARN, versionName, state, minInferenceUnits, maxInferenceUnits)
+}
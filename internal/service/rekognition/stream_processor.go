@@ -0,0 +1,655 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rekognition_stream_processor", name="Stream Processor")
+// @Tags(identifierAttribute="arn")
+func ResourceStreamProcessor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStreamProcessorCreate,
+		ReadWithoutTimeout:   resourceStreamProcessorRead,
+		UpdateWithoutTimeout: resourceStreamProcessorUpdate,
+		DeleteWithoutTimeout: resourceStreamProcessorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_sharing_preference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"opt_in": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_video_stream": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must contain only alphanumeric characters, underscores, hyphens and periods"),
+				),
+			},
+			"notification_channel": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sns_topic_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_data_stream": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"output.0.kinesis_data_stream", "output.0.s3_destination"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_destination": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"output.0.kinesis_data_stream", "output.0.s3_destination"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+									"key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connected_home": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"settings.0.connected_home", "settings.0.face_search"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"labels": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(connectedHomeLabel_Values(), false),
+										},
+									},
+									"min_confidence": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+						},
+						"face_search": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"settings.0.connected_home", "settings.0.face_search"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"face_match_threshold": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// Only the connected home settings of an existing stream processor can be updated.
+			customdiff.ForceNewIfChange("settings.0.connected_home.#", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(int) != new.(int)
+			}),
+		),
+	}
+}
+
+const (
+	connectedHomeLabelAll     = "ALL"
+	connectedHomeLabelPackage = "PACKAGE"
+	connectedHomeLabelPerson  = "PERSON"
+	connectedHomeLabelPet     = "PET"
+)
+
+func connectedHomeLabel_Values() []string {
+	return []string{
+		connectedHomeLabelAll,
+		connectedHomeLabelPackage,
+		connectedHomeLabelPerson,
+		connectedHomeLabelPet,
+	}
+}
+
+func resourceStreamProcessorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &rekognition.CreateStreamProcessorInput{
+		Input:    expandStreamProcessorInput(d.Get("input").([]interface{})),
+		Name:     aws.String(name),
+		Output:   expandStreamProcessorOutput(d.Get("output").([]interface{})),
+		RoleArn:  aws.String(d.Get("role_arn").(string)),
+		Settings: expandStreamProcessorSettings(d.Get("settings").([]interface{})),
+		Tags:     getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("data_sharing_preference"); ok {
+		input.DataSharingPreference = expandStreamProcessorDataSharingPreference(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_channel"); ok {
+		input.NotificationChannel = expandStreamProcessorNotificationChannel(v.([]interface{}))
+	}
+
+	_, err := conn.CreateStreamProcessorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Rekognition Stream Processor (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceStreamProcessorRead(ctx, d, meta)...)
+}
+
+func resourceStreamProcessorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	streamProcessor, err := FindStreamProcessorByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Stream Processor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Stream Processor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", streamProcessor.StreamProcessorArn)
+	if err := d.Set("data_sharing_preference", flattenStreamProcessorDataSharingPreference(streamProcessor.DataSharingPreference)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_sharing_preference: %s", err)
+	}
+	if err := d.Set("input", flattenStreamProcessorInput(streamProcessor.Input)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input: %s", err)
+	}
+	d.Set("kms_key_id", streamProcessor.KmsKeyId)
+	d.Set("name", streamProcessor.Name)
+	if err := d.Set("notification_channel", flattenStreamProcessorNotificationChannel(streamProcessor.NotificationChannel)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting notification_channel: %s", err)
+	}
+	if err := d.Set("output", flattenStreamProcessorOutput(streamProcessor.Output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output: %s", err)
+	}
+	d.Set("role_arn", streamProcessor.RoleArn)
+	if err := d.Set("settings", flattenStreamProcessorSettings(streamProcessor.Settings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting settings: %s", err)
+	}
+	d.Set("status", streamProcessor.Status)
+
+	return diags
+}
+
+func resourceStreamProcessorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	if d.HasChanges("data_sharing_preference", "settings") {
+		input := &rekognition.UpdateStreamProcessorInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_sharing_preference") {
+			input.DataSharingPreferenceForUpdate = expandStreamProcessorDataSharingPreference(d.Get("data_sharing_preference").([]interface{}))
+		}
+
+		if d.HasChange("settings.0.connected_home") {
+			input.SettingsForUpdate = &rekognition.StreamProcessorSettingsForUpdate{
+				ConnectedHomeForUpdate: expandConnectedHomeSettingsForUpdate(d.Get("settings.0.connected_home").([]interface{})),
+			}
+		}
+
+		_, err := conn.UpdateStreamProcessorWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Rekognition Stream Processor (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitStreamProcessorUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Stream Processor (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStreamProcessorRead(ctx, d, meta)...)
+}
+
+func resourceStreamProcessorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn(ctx)
+
+	log.Printf("[INFO] Deleting Rekognition Stream Processor: %s", d.Id())
+	_, err := conn.DeleteStreamProcessorWithContext(ctx, &rekognition.DeleteStreamProcessorInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Rekognition Stream Processor (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindStreamProcessorByName(ctx context.Context, conn *rekognition.Rekognition, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	input := &rekognition.DescribeStreamProcessorInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeStreamProcessorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusStreamProcessor(ctx context.Context, conn *rekognition.Rekognition, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindStreamProcessorByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitStreamProcessorUpdated(ctx context.Context, conn *rekognition.Rekognition, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{rekognition.StreamProcessorStatusUpdating},
+		Target:  []string{rekognition.StreamProcessorStatusStopped},
+		Refresh: statusStreamProcessor(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandStreamProcessorInput(tfList []interface{}) *rekognition.StreamProcessorInput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.StreamProcessorInput{}
+
+	if v, ok := tfMap["kinesis_video_stream"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisVideoStream = &rekognition.KinesisVideoStream{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandStreamProcessorOutput(tfList []interface{}) *rekognition.StreamProcessorOutput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.StreamProcessorOutput{}
+
+	if v, ok := tfMap["kinesis_data_stream"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisDataStream = &rekognition.KinesisDataStream{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3Destination = &rekognition.S3Destination{
+			Bucket: aws.String(tfMap["bucket"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			apiObject.S3Destination.KeyPrefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandStreamProcessorSettings(tfList []interface{}) *rekognition.StreamProcessorSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.StreamProcessorSettings{}
+
+	if v, ok := tfMap["connected_home"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ConnectedHome = &rekognition.ConnectedHomeSettings{
+			Labels: flex.ExpandStringSet(tfMap["labels"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["min_confidence"].(float64); ok && v != 0 {
+			apiObject.ConnectedHome.MinConfidence = aws.Float64(v)
+		}
+	}
+
+	if v, ok := tfMap["face_search"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.FaceSearch = &rekognition.FaceSearchSettings{
+			CollectionId: aws.String(tfMap["collection_id"].(string)),
+		}
+
+		if v, ok := tfMap["face_match_threshold"].(float64); ok && v != 0 {
+			apiObject.FaceSearch.FaceMatchThreshold = aws.Float64(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandConnectedHomeSettingsForUpdate(tfList []interface{}) *rekognition.ConnectedHomeSettingsForUpdate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.ConnectedHomeSettingsForUpdate{
+		Labels: flex.ExpandStringSet(tfMap["labels"].(*schema.Set)),
+	}
+
+	if v, ok := tfMap["min_confidence"].(float64); ok && v != 0 {
+		apiObject.MinConfidence = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
+func expandStreamProcessorDataSharingPreference(tfList []interface{}) *rekognition.StreamProcessorDataSharingPreference {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &rekognition.StreamProcessorDataSharingPreference{
+		OptIn: aws.Bool(tfMap["opt_in"].(bool)),
+	}
+}
+
+func expandStreamProcessorNotificationChannel(tfList []interface{}) *rekognition.StreamProcessorNotificationChannel {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &rekognition.StreamProcessorNotificationChannel{
+		SNSTopicArn: aws.String(tfMap["sns_topic_arn"].(string)),
+	}
+}
+
+func flattenStreamProcessorInput(apiObject *rekognition.StreamProcessorInput) []interface{} {
+	if apiObject == nil || apiObject.KinesisVideoStream == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"kinesis_video_stream": []interface{}{map[string]interface{}{
+			"arn": aws.StringValue(apiObject.KinesisVideoStream.Arn),
+		}},
+	}}
+}
+
+func flattenStreamProcessorOutput(apiObject *rekognition.StreamProcessorOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KinesisDataStream; v != nil {
+		tfMap["kinesis_data_stream"] = []interface{}{map[string]interface{}{
+			"arn": aws.StringValue(v.Arn),
+		}}
+	}
+
+	if v := apiObject.S3Destination; v != nil {
+		tfMap["s3_destination"] = []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(v.Bucket),
+			"key_prefix": aws.StringValue(v.KeyPrefix),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStreamProcessorSettings(apiObject *rekognition.StreamProcessorSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConnectedHome; v != nil {
+		tfMap["connected_home"] = []interface{}{map[string]interface{}{
+			"labels":         aws.StringValueSlice(v.Labels),
+			"min_confidence": aws.Float64Value(v.MinConfidence),
+		}}
+	}
+
+	if v := apiObject.FaceSearch; v != nil {
+		tfMap["face_search"] = []interface{}{map[string]interface{}{
+			"collection_id":        aws.StringValue(v.CollectionId),
+			"face_match_threshold": aws.Float64Value(v.FaceMatchThreshold),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStreamProcessorDataSharingPreference(apiObject *rekognition.StreamProcessorDataSharingPreference) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"opt_in": aws.BoolValue(apiObject.OptIn),
+	}}
+}
+
+func flattenStreamProcessorNotificationChannel(apiObject *rekognition.StreamProcessorNotificationChannel) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"sns_topic_arn": aws.StringValue(apiObject.SNSTopicArn),
+	}}
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53domains
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go-v2/service/route53domains/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	delegationSignerRecordResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_route53domains_delegation_signer_record", name="Delegation Signer Record")
+func ResourceDelegationSignerRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDelegationSignerRecordCreate,
+		ReadWithoutTimeout:   resourceDelegationSignerRecordRead,
+		DeleteWithoutTimeout: resourceDelegationSignerRecordDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"digest_type": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dnssec_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_tag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"signing_attributes": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"flags": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntInSlice([]int{256, 257}),
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDelegationSignerRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53DomainsClient(ctx)
+
+	domainName := d.Get("domain_name").(string)
+	signingAttributes := expandDNSSECSigningAttributes(d.Get("signing_attributes").([]interface{})[0].(map[string]interface{}))
+	input := &route53domains.AssociateDelegationSignerToDomainInput{
+		DomainName:        aws.String(domainName),
+		SigningAttributes: signingAttributes,
+	}
+
+	output, err := conn.AssociateDelegationSignerToDomain(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Domains Delegation Signer Record (%s): %s", domainName, err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Domains Delegation Signer Record (%s) create: %s", domainName, err)
+	}
+
+	// The association response doesn't include the new key's ID.
+	dnssecKey, err := findDNSSECKeyByPublicKey(ctx, conn, domainName, aws.ToString(signingAttributes.PublicKey))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Domains Delegation Signer Record (%s): %s", domainName, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{domainName, aws.ToString(dnssecKey.Id)}, delegationSignerRecordResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDelegationSignerRecordRead(ctx, d, meta)...)
+}
+
+func resourceDelegationSignerRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53DomainsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), delegationSignerRecordResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, dnssecKeyID := parts[0], parts[1]
+	dnssecKey, err := findDNSSECKeyByTwoPartKey(ctx, conn, domainName, dnssecKeyID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Domains Delegation Signer Record (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Domains Delegation Signer Record (%s): %s", d.Id(), err)
+	}
+
+	d.Set("digest", dnssecKey.Digest)
+	d.Set("digest_type", dnssecKey.DigestType)
+	d.Set("dnssec_key_id", dnssecKey.Id)
+	d.Set("domain_name", domainName)
+	d.Set("key_tag", dnssecKey.KeyTag)
+	if err := d.Set("signing_attributes", []interface{}{flattenDNSSECKeySigningAttributes(dnssecKey)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting signing_attributes: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDelegationSignerRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53DomainsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), delegationSignerRecordResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domainName, dnssecKeyID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting Route 53 Domains Delegation Signer Record: %s", d.Id())
+	output, err := conn.DisassociateDelegationSignerFromDomain(ctx, &route53domains.DisassociateDelegationSignerFromDomainInput{
+		DomainName: aws.String(domainName),
+		Id:         aws.String(dnssecKeyID),
+	})
+
+	if err != nil {
+		if _, err := findDNSSECKeyByTwoPartKey(ctx, conn, domainName, dnssecKeyID); tfresource.NotFound(err) {
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Domains Delegation Signer Record (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitOperationSucceeded(ctx, conn, aws.ToString(output.OperationId), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Domains Delegation Signer Record (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDNSSECKey(ctx context.Context, conn *route53domains.Client, domainName string, filter func(*types.DnssecKey) bool) (*types.DnssecKey, error) {
+	output, err := findDomainDetailByName(ctx, conn, domainName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.DnssecKeys {
+		v := v
+		if filter(&v) {
+			return &v, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{}
+}
+
+func findDNSSECKeyByTwoPartKey(ctx context.Context, conn *route53domains.Client, domainName, id string) (*types.DnssecKey, error) {
+	return findDNSSECKey(ctx, conn, domainName, func(v *types.DnssecKey) bool {
+		return aws.ToString(v.Id) == id
+	})
+}
+
+func findDNSSECKeyByPublicKey(ctx context.Context, conn *route53domains.Client, domainName, publicKey string) (*types.DnssecKey, error) {
+	dnssecKey, err := findDNSSECKey(ctx, conn, domainName, func(v *types.DnssecKey) bool {
+		return aws.ToString(v.PublicKey) == publicKey
+	})
+
+	if tfresource.NotFound(err) {
+		return nil, fmt.Errorf("DNSSEC key with public key not found: %w", err)
+	}
+
+	return dnssecKey, err
+}
+
+func expandDNSSECSigningAttributes(tfMap map[string]interface{}) *types.DnssecSigningAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DnssecSigningAttributes{}
+
+	if v, ok := tfMap["algorithm"].(int); ok {
+		apiObject.Algorithm = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["flags"].(int); ok {
+		apiObject.Flags = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["public_key"].(string); ok && v != "" {
+		apiObject.PublicKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDNSSECKeySigningAttributes(apiObject *types.DnssecKey) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"algorithm":  aws.ToInt32(apiObject.Algorithm),
+		"flags":      aws.ToInt32(apiObject.Flags),
+		"public_key": aws.ToString(apiObject.PublicKey),
+	}
+
+	return tfMap
+}
@@ -21,6 +21,9 @@ func TestAccRoute53Domains_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DelegationSignerRecord": {
+			"basic": testAccDelegationSignerRecord_basic,
+		},
 		"RegisteredDomain": {
 			"tags":           testAccRegisteredDomain_tags,
 			"autoRenew":      testAccRegisteredDomain_autoRenew,
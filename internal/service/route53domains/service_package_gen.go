@@ -26,6 +26,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  ResourceDelegationSignerRecord,
+			TypeName: "aws_route53domains_delegation_signer_record",
+			Name:     "Delegation Signer Record",
+		},
 		{
 			Factory:  ResourceRegisteredDomain,
 			TypeName: "aws_route53domains_registered_domain",
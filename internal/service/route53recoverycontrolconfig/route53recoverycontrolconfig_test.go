@@ -28,6 +28,9 @@ func TestAccRoute53RecoveryControlConfig_serial(t *testing.T) {
 			"disappears":            testAccRoutingControl_disappears,
 			"nonDefaultControlPane": testAccRoutingControl_nonDefaultControlPanel,
 		},
+		"RoutingControlState": {
+			"basic": testAccRoutingControlState_basic,
+		},
 		"SafetyRule": {
 			"assertionRule": testAccSafetyRule_assertionRule,
 			"gatingRule":    testAccSafetyRule_gatingRule,
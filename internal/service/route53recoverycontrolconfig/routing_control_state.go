@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53recoverycontrolconfig
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	r53rc "github.com/aws/aws-sdk-go/service/route53recoverycluster"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_route53recoverycontrolconfig_routing_control_state")
+func ResourceRoutingControlState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRoutingControlStateCreate,
+		ReadWithoutTimeout:   resourceRoutingControlStateRead,
+		UpdateWithoutTimeout: resourceRoutingControlStateUpdate,
+		DeleteWithoutTimeout: schema.NoopContext, // The routing control is left in its current state.
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"routing_control_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"safety_rules_to_override": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(r53rc.RoutingControlState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceRoutingControlStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	routingControlARN := d.Get("routing_control_arn").(string)
+
+	if err := putRoutingControlState(ctx, meta.(*conns.AWSClient), d, routingControlARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Route53 Recovery Control Config Routing Control (%s) state: %s", routingControlARN, err)
+	}
+
+	d.SetId(routingControlARN)
+
+	return append(diags, resourceRoutingControlStateRead(ctx, d, meta)...)
+}
+
+func resourceRoutingControlStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := putRoutingControlState(ctx, meta.(*conns.AWSClient), d, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Route53 Recovery Control Config Routing Control (%s) state: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRoutingControlStateRead(ctx, d, meta)...)
+}
+
+func resourceRoutingControlStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	clusterARN, clusterConns, err := routingControlClusterConns(ctx, meta.(*conns.AWSClient), d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Route53 Recovery Control Config Routing Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Routing Control (%s) state: %s", d.Id(), err)
+	}
+
+	output, err := findRoutingControlStateByARN(ctx, clusterConns, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route53 Recovery Control Config Routing Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Routing Control (%s) state: %s", d.Id(), err)
+	}
+
+	d.Set("cluster_arn", clusterARN)
+	d.Set("routing_control_arn", output.RoutingControlArn)
+	d.Set("state", output.RoutingControlState)
+
+	return diags
+}
+
+func putRoutingControlState(ctx context.Context, client *conns.AWSClient, d *schema.ResourceData, routingControlARN string, timeout time.Duration) error {
+	_, clusterConns, err := routingControlClusterConns(ctx, client, routingControlARN)
+
+	if err != nil {
+		return err
+	}
+
+	state := d.Get("state").(string)
+	input := &r53rc.UpdateRoutingControlStateInput{
+		RoutingControlArn:   aws.String(routingControlARN),
+		RoutingControlState: aws.String(state),
+	}
+
+	if v, ok := d.GetOk("safety_rules_to_override"); ok && v.(*schema.Set).Len() > 0 {
+		input.SafetyRulesToOverride = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	// Safety rules are evaluated by the service. A change that would violate an
+	// assertion or gating rule is rejected with a ConflictException.
+	err = withClusterEndpoints(ctx, clusterConns, func(ctx context.Context, conn *r53rc.Route53RecoveryCluster) error {
+		_, err := conn.UpdateRoutingControlStateWithContext(ctx, input)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitRoutingControlStateUpdated(ctx, clusterConns, routingControlARN, state, timeout); err != nil {
+		return fmt.Errorf("waiting for state propagation: %w", err)
+	}
+
+	return nil
+}
+
+// routingControlClusterConns returns the ARN of the cluster that hosts the specified routing control
+// and a data plane client for each of the cluster's Regional endpoints.
+func routingControlClusterConns(ctx context.Context, client *conns.AWSClient, routingControlARN string) (string, []*r53rc.Route53RecoveryCluster, error) {
+	conn := client.Route53RecoveryControlConfigConn(ctx)
+
+	routingControl, err := conn.DescribeRoutingControlWithContext(ctx, &r53rcc.DescribeRoutingControlInput{
+		RoutingControlArn: aws.String(routingControlARN),
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if routingControl == nil || routingControl.RoutingControl == nil {
+		return "", nil, tfresource.NewEmptyResultError(routingControlARN)
+	}
+
+	controlPanel, err := conn.DescribeControlPanelWithContext(ctx, &r53rcc.DescribeControlPanelInput{
+		ControlPanelArn: routingControl.RoutingControl.ControlPanelArn,
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if controlPanel == nil || controlPanel.ControlPanel == nil {
+		return "", nil, tfresource.NewEmptyResultError(aws.StringValue(routingControl.RoutingControl.ControlPanelArn))
+	}
+
+	clusterARN := aws.StringValue(controlPanel.ControlPanel.ClusterArn)
+	cluster, err := conn.DescribeClusterWithContext(ctx, &r53rcc.DescribeClusterInput{
+		ClusterArn: aws.String(clusterARN),
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if cluster == nil || cluster.Cluster == nil || len(cluster.Cluster.ClusterEndpoints) == 0 {
+		return "", nil, fmt.Errorf("Route53 Recovery Control Config Cluster (%s) has no endpoints", clusterARN)
+	}
+
+	var clusterConns []*r53rc.Route53RecoveryCluster
+
+	for _, v := range cluster.Cluster.ClusterEndpoints {
+		clusterConns = append(clusterConns, r53rc.New(client.Session.Copy(&aws.Config{
+			Endpoint: v.Endpoint,
+			Region:   v.Region,
+		})))
+	}
+
+	return clusterARN, clusterConns, nil
+}
+
+// clusterEndpointTimeout bounds each request to a single cluster endpoint.
+const clusterEndpointTimeout = 30 * time.Second
+
+// withClusterEndpoints calls f with each cluster endpoint's client in turn until one succeeds.
+// Any single cluster endpoint may be unavailable, so AWS recommends retrying requests against the other endpoints.
+// Each call is bounded by clusterEndpointTimeout so that an unresponsive endpoint doesn't prevent failover.
+func withClusterEndpoints(ctx context.Context, clusterConns []*r53rc.Route53RecoveryCluster, f func(context.Context, *r53rc.Route53RecoveryCluster) error) error {
+	var err error
+
+	for _, conn := range clusterConns {
+		endpointCtx, cancel := context.WithTimeout(ctx, clusterEndpointTimeout)
+		err = f(endpointCtx, conn)
+		timedOut := endpointCtx.Err() != nil && ctx.Err() == nil
+		cancel()
+
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		// Connection errors and timeouts are returned when an endpoint can't be reached.
+		if timedOut || tfawserr.ErrCodeEquals(err, r53rc.ErrCodeEndpointTemporarilyUnavailableException, r53rc.ErrCodeInternalServerException, r53rc.ErrCodeThrottlingException, request.ErrCodeRequestError, request.ErrCodeResponseTimeout) {
+			continue
+		}
+
+		return err
+	}
+
+	return err
+}
+
+func findRoutingControlStateByARN(ctx context.Context, clusterConns []*r53rc.Route53RecoveryCluster, arn string) (*r53rc.GetRoutingControlStateOutput, error) {
+	input := &r53rc.GetRoutingControlStateInput{
+		RoutingControlArn: aws.String(arn),
+	}
+	var output *r53rc.GetRoutingControlStateOutput
+
+	err := withClusterEndpoints(ctx, clusterConns, func(ctx context.Context, conn *r53rc.Route53RecoveryCluster) error {
+		var err error
+		output, err = conn.GetRoutingControlStateWithContext(ctx, input)
+
+		return err
+	})
+
+	if tfawserr.ErrCodeEquals(err, r53rc.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRoutingControlState(ctx context.Context, clusterConns []*r53rc.Route53RecoveryCluster, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findRoutingControlStateByARN(ctx, clusterConns, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.RoutingControlState), nil
+	}
+}
+
+// waitRoutingControlStateUpdated waits for a routing control state change to propagate to the cluster.
+func waitRoutingControlStateUpdated(ctx context.Context, clusterConns []*r53rc.Route53RecoveryCluster, arn, state string, timeout time.Duration) (*r53rc.GetRoutingControlStateOutput, error) {
+	var pending []string
+	for _, v := range r53rc.RoutingControlState_Values() {
+		if v != state {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:                   pending,
+		Target:                    []string{state},
+		Refresh:                   statusRoutingControlState(ctx, clusterConns, arn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*r53rc.GetRoutingControlStateOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53recoverycontrolconfig
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	r53rc "github.com/aws/aws-sdk-go/service/route53recoverycluster"
+)
+
+func TestWithClusterEndpoints(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		errs      []error
+		wantCalls int
+		wantErr   bool
+	}{
+		"first succeeds": {
+			errs:      []error{nil, nil},
+			wantCalls: 1,
+		},
+		"endpoint unavailable": {
+			errs:      []error{awserr.New(r53rc.ErrCodeEndpointTemporarilyUnavailableException, "unavailable", nil), nil},
+			wantCalls: 2,
+		},
+		"connection error": {
+			errs:      []error{awserr.New(request.ErrCodeRequestError, "send request failed", errors.New("dial tcp: i/o timeout")), nil},
+			wantCalls: 2,
+		},
+		"response timeout": {
+			errs:      []error{awserr.New(request.ErrCodeResponseTimeout, "read timeout", nil), nil},
+			wantCalls: 2,
+		},
+		"validation error": {
+			errs:      []error{awserr.New(r53rc.ErrCodeValidationException, "invalid", nil), nil},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		"all unavailable": {
+			errs: []error{
+				awserr.New(request.ErrCodeRequestError, "send request failed", nil),
+				awserr.New(r53rc.ErrCodeInternalServerException, "internal", nil),
+			},
+			wantCalls: 2,
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			clusterConns := make([]*r53rc.Route53RecoveryCluster, len(testCase.errs))
+			for i := range clusterConns {
+				clusterConns[i] = &r53rc.Route53RecoveryCluster{}
+			}
+
+			calls := 0
+			err := withClusterEndpoints(context.Background(), clusterConns, func(ctx context.Context, conn *r53rc.Route53RecoveryCluster) error {
+				err := testCase.errs[calls]
+				calls++
+
+				return err
+			})
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("err = %v, want error: %t", err, want)
+			}
+
+			if got, want := calls, testCase.wantCalls; got != want {
+				t.Errorf("calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestWithClusterEndpoints_cancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	clusterConns := []*r53rc.Route53RecoveryCluster{{}, {}}
+
+	calls := 0
+	err := withClusterEndpoints(ctx, clusterConns, func(ctx context.Context, conn *r53rc.Route53RecoveryCluster) error {
+		calls++
+		cancel()
+
+		return awserr.New(request.CanceledErrorCode, "canceled", ctx.Err())
+	})
+
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
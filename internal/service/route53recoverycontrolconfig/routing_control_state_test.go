@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53recoverycontrolconfig_test
+
+import (
+	"fmt"
+	"testing"
+
+	r53rc "github.com/aws/aws-sdk-go/service/route53recoverycluster"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccRoutingControlState_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53recoverycontrolconfig_routing_control_state.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, r53rcc.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, r53rcc.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoutingControlDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingControlStateConfig_basic(rName, r53rc.RoutingControlStateOn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_arn", "aws_route53recoverycontrolconfig_cluster.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_control_arn", "aws_route53recoverycontrolconfig_routing_control.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "state", r53rc.RoutingControlStateOn),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoutingControlStateConfig_basic(rName, r53rc.RoutingControlStateOff),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", r53rc.RoutingControlStateOff),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutingControlStateConfig_basic(rName, state string) string {
+	return acctest.ConfigCompose(
+		testAccRoutingControlConfig_inDefaultPanel(rName),
+		fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_routing_control_state" "test" {
+  routing_control_arn = aws_route53recoverycontrolconfig_routing_control.test.arn
+  state               = %[1]q
+}
+`, state))
+}
@@ -38,6 +38,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRoutingControl,
 			TypeName: "aws_route53recoverycontrolconfig_routing_control",
 		},
+		{
+			Factory:  ResourceRoutingControlState,
+			TypeName: "aws_route53recoverycontrolconfig_routing_control_state",
+		},
 		{
 			Factory:  ResourceSafetyRule,
 			TypeName: "aws_route53recoverycontrolconfig_safety_rule",
@@ -641,3 +641,53 @@ func FindPipelineByName(ctx context.Context, conn *sagemaker.SageMaker, name str
 
 	return output, nil
 }
+
+func FindModelCardByName(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeModelCardOutput, error) {
+	input := &sagemaker.DescribeModelCardInput{
+		ModelCardName: aws.String(name),
+	}
+
+	output, err := conn.DescribeModelCardWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindModelPackageByName(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeModelPackageOutput, error) {
+	input := &sagemaker.DescribeModelPackageInput{
+		ModelPackageName: aws.String(name),
+	}
+
+	output, err := conn.DescribeModelPackageWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, "ValidationException", "does not exist") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_sagemaker_model_card", name="Model Card")
+// @Tags(identifierAttribute="arn")
+func ResourceModelCard() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceModelCardCreate,
+		ReadWithoutTimeout:   resourceModelCardRead,
+		UpdateWithoutTimeout: resourceModelCardUpdate,
+		DeleteWithoutTimeout: resourceModelCardDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 100000),
+					validation.StringIsJSON,
+				),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"model_card_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexache.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9]){0,62}$`),
+						"Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
+				),
+			},
+			"model_card_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.ModelCardStatus_Values(), false),
+			},
+			"model_card_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceModelCardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	name := d.Get("model_card_name").(string)
+	input := &sagemaker.CreateModelCardInput{
+		ModelCardName:   aws.String(name),
+		ModelCardStatus: aws.String(d.Get("model_card_status").(string)),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("content"); ok {
+		input.Content = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SecurityConfig = &sagemaker.ModelCardSecurityConfig{
+			KmsKeyId: aws.String(v.(string)),
+		}
+	}
+
+	_, err := conn.CreateModelCardWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker Model Card (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceModelCardRead(ctx, d, meta)...)
+}
+
+func resourceModelCardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	card, err := FindModelCardByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Model Card (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Model Card (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", card.ModelCardArn)
+	d.Set("content", card.Content)
+	if card.SecurityConfig != nil {
+		d.Set("kms_key_id", card.SecurityConfig.KmsKeyId)
+	} else {
+		d.Set("kms_key_id", nil)
+	}
+	d.Set("model_card_name", card.ModelCardName)
+	d.Set("model_card_status", card.ModelCardStatus)
+	d.Set("model_card_version", card.ModelCardVersion)
+
+	return diags
+}
+
+func resourceModelCardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &sagemaker.UpdateModelCardInput{
+			ModelCardName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("content") {
+			input.Content = aws.String(d.Get("content").(string))
+		}
+
+		if d.HasChange("model_card_status") {
+			input.ModelCardStatus = aws.String(d.Get("model_card_status").(string))
+		}
+
+		_, err := conn.UpdateModelCardWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Model Card (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceModelCardRead(ctx, d, meta)...)
+}
+
+func resourceModelCardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	log.Printf("[DEBUG] Deleting SageMaker Model Card: %s", d.Id())
+	_, err := conn.DeleteModelCardWithContext(ctx, &sagemaker.DeleteModelCardInput{
+		ModelCardName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker Model Card (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
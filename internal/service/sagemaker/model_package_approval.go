@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_sagemaker_model_package_approval", name="Model Package Approval")
+func ResourceModelPackageApproval() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceModelPackageApprovalPut,
+		ReadWithoutTimeout:   resourceModelPackageApprovalRead,
+		UpdateWithoutTimeout: resourceModelPackageApprovalPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"approval_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"model_approval_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.ModelApprovalStatus_Values(), false),
+			},
+			"model_package_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"model_package_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceModelPackageApprovalPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	arn := d.Get("model_package_arn").(string)
+	input := &sagemaker.UpdateModelPackageInput{
+		ModelApprovalStatus: aws.String(d.Get("model_approval_status").(string)),
+		ModelPackageArn:     aws.String(arn),
+	}
+
+	// Send an empty description when it's removed so that the previous one is cleared.
+	if v, ok := d.GetOk("approval_description"); ok || (!d.IsNewResource() && d.HasChange("approval_description")) {
+		input.ApprovalDescription = aws.String(v.(string))
+	}
+
+	_, err := conn.UpdateModelPackageWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SageMaker Model Package (%s) approval status: %s", arn, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(arn)
+	}
+
+	return append(diags, resourceModelPackageApprovalRead(ctx, d, meta)...)
+}
+
+func resourceModelPackageApprovalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn(ctx)
+
+	mp, err := FindModelPackageByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Model Package (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Model Package (%s): %s", d.Id(), err)
+	}
+
+	d.Set("approval_description", mp.ApprovalDescription)
+	d.Set("model_approval_status", mp.ModelApprovalStatus)
+	d.Set("model_package_arn", mp.ModelPackageArn)
+	d.Set("model_package_group_name", mp.ModelPackageGroupName)
+	d.Set("model_package_version", mp.ModelPackageVersion)
+
+	return diags
+}
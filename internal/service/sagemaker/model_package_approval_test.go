@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+)
+
+// There's no resource for versioned model packages, so they're created and deleted
+// in test checks within a model package group managed by the test configuration.
+// The model package must be deleted before the group can be.
+
+func TestAccSageMakerModelPackageApproval_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_package_approval.test"
+	groupResourceName := "aws_sagemaker_model_package_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelPackageApprovalConfig_base(rName),
+				Check:  testAccCreateModelPackage(ctx, rName),
+			},
+			{
+				Config: testAccModelPackageApprovalConfig_basic(rName, "Approved"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelPackageApprovalStatus(ctx, resourceName, "Approved", ""),
+					resource.TestCheckResourceAttr(resourceName, "model_approval_status", "Approved"),
+					resource.TestCheckResourceAttr(resourceName, "approval_description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "model_package_group_name", groupResourceName, "model_package_group_name"),
+					resource.TestCheckResourceAttr(resourceName, "model_package_version", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "model_package_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Destroying the resource leaves the approval status unchanged.
+				Config: testAccModelPackageApprovalConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelPackageStatus(ctx, "Approved", ""),
+					testAccDeleteModelPackage(ctx),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerModelPackageApproval_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_package_approval.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelPackageApprovalConfig_base(rName),
+				Check:  testAccCreateModelPackage(ctx, rName),
+			},
+			{
+				Config: testAccModelPackageApprovalConfig_description(rName, "Approved", "passed evaluation"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelPackageApprovalStatus(ctx, resourceName, "Approved", "passed evaluation"),
+					resource.TestCheckResourceAttr(resourceName, "model_approval_status", "Approved"),
+					resource.TestCheckResourceAttr(resourceName, "approval_description", "passed evaluation"),
+				),
+			},
+			{
+				Config: testAccModelPackageApprovalConfig_description(rName, "Rejected", "failed monitoring"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelPackageApprovalStatus(ctx, resourceName, "Rejected", "failed monitoring"),
+					resource.TestCheckResourceAttr(resourceName, "model_approval_status", "Rejected"),
+					resource.TestCheckResourceAttr(resourceName, "approval_description", "failed monitoring"),
+				),
+			},
+			{
+				// Removing the description clears it.
+				Config: testAccModelPackageApprovalConfig_basic(rName, "Rejected"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelPackageApprovalStatus(ctx, resourceName, "Rejected", ""),
+					resource.TestCheckResourceAttr(resourceName, "approval_description", ""),
+				),
+			},
+			{
+				Config: testAccModelPackageApprovalConfig_base(rName),
+				Check:  testAccDeleteModelPackage(ctx),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerModelPackageApproval_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_package_approval.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelPackageApprovalConfig_base(rName),
+				Check:  testAccCreateModelPackage(ctx, rName),
+			},
+			{
+				// Deleting the resource doesn't delete the model package, so delete the model package itself.
+				Config: testAccModelPackageApprovalConfig_basic(rName, "Approved"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelPackageApprovalStatus(ctx, resourceName, "Approved", ""),
+					testAccDeleteModelPackage(ctx),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckModelPackageApprovalStatus(ctx context.Context, n, status, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Model Package Approval ID is set")
+		}
+
+		return testAccCheckModelPackageStatusByName(ctx, rs.Primary.ID, status, description)
+	}
+}
+
+func testAccCheckModelPackageStatus(ctx context.Context, status, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		arn, err := testAccModelPackageARN(s)
+
+		if err != nil {
+			return err
+		}
+
+		return testAccCheckModelPackageStatusByName(ctx, arn, status, description)
+	}
+}
+
+func testAccCheckModelPackageStatusByName(ctx context.Context, name, status, description string) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn(ctx)
+
+	output, err := tfsagemaker.FindModelPackageByName(ctx, conn, name)
+
+	if err != nil {
+		return err
+	}
+
+	if got := aws.StringValue(output.ModelApprovalStatus); got != status {
+		return fmt.Errorf("SageMaker Model Package (%s) approval status is %s, want %s", name, got, status)
+	}
+
+	if got := aws.StringValue(output.ApprovalDescription); got != description {
+		return fmt.Errorf("SageMaker Model Package (%s) approval description is %q, want %q", name, got, description)
+	}
+
+	return nil
+}
+
+// testAccModelPackageARN returns the ARN of the first version in the test model package group.
+func testAccModelPackageARN(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["aws_sagemaker_model_package_group.test"]
+	if !ok {
+		return "", fmt.Errorf("Not found: aws_sagemaker_model_package_group.test")
+	}
+
+	return strings.Replace(rs.Primary.Attributes["arn"], "model-package-group", "model-package", 1) + "/1", nil
+}
+
+func testAccCreateModelPackage(ctx context.Context, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn(ctx)
+
+		rs, ok := s.RootModule().Resources["data.aws_sagemaker_prebuilt_ecr_image.test"]
+		if !ok {
+			return fmt.Errorf("Not found: data.aws_sagemaker_prebuilt_ecr_image.test")
+		}
+
+		_, err := conn.CreateModelPackageWithContext(ctx, &sagemaker.CreateModelPackageInput{
+			InferenceSpecification: &sagemaker.InferenceSpecification{
+				Containers: []*sagemaker.ModelPackageContainerDefinition{{
+					Image: aws.String(rs.Primary.Attributes["registry_path"]),
+				}},
+				SupportedContentTypes:      aws.StringSlice([]string{"text/csv"}),
+				SupportedResponseMIMETypes: aws.StringSlice([]string{"text/csv"}),
+			},
+			ModelApprovalStatus:   aws.String(sagemaker.ModelApprovalStatusPendingManualApproval),
+			ModelPackageGroupName: aws.String(groupName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("creating SageMaker Model Package in group (%s): %w", groupName, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccDeleteModelPackage(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn(ctx)
+
+		arn, err := testAccModelPackageARN(s)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DeleteModelPackageWithContext(ctx, &sagemaker.DeleteModelPackageInput{
+			ModelPackageName: aws.String(arn),
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting SageMaker Model Package (%s): %w", arn, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccModelPackageApprovalConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_model_package_group" "test" {
+  model_package_group_name = %[1]q
+}
+
+data "aws_sagemaker_prebuilt_ecr_image" "test" {
+  repository_name = "kmeans"
+}
+`, rName)
+}
+
+func testAccModelPackageApprovalConfig_basic(rName, status string) string {
+	return acctest.ConfigCompose(testAccModelPackageApprovalConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_model_package_approval" "test" {
+  model_package_arn     = "${replace(aws_sagemaker_model_package_group.test.arn, "model-package-group", "model-package")}/1"
+  model_approval_status = %[1]q
+}
+`, status))
+}
+
+func testAccModelPackageApprovalConfig_description(rName, status, description string) string {
+	return acctest.ConfigCompose(testAccModelPackageApprovalConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_model_package_approval" "test" {
+  model_package_arn     = "${replace(aws_sagemaker_model_package_group.test.arn, "model-package-group", "model-package")}/1"
+  model_approval_status = %[1]q
+  approval_description  = %[2]q
+}
+`, status, description))
+}
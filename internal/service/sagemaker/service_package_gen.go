@@ -146,6 +146,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "arn",
 			},
 		},
+		{
+			Factory:  ResourceModelCard,
+			TypeName: "aws_sagemaker_model_card",
+			Name:     "Model Card",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "arn",
+			},
+		},
+		{
+			Factory:  ResourceModelPackageApproval,
+			TypeName: "aws_sagemaker_model_package_approval",
+			Name:     "Model Package Approval",
+		},
 		{
 			Factory:  ResourceModelPackageGroup,
 			TypeName: "aws_sagemaker_model_package_group",
@@ -87,6 +87,11 @@ func init() {
 		F:    sweepImages,
 	})
 
+	resource.AddTestSweepers("aws_sagemaker_model_card", &resource.Sweeper{
+		Name: "aws_sagemaker_model_card",
+		F:    sweepModelCards,
+	})
+
 	resource.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
 		Name: "aws_sagemaker_model_package_group",
 		F:    sweepModelPackageGroups,
@@ -642,6 +647,44 @@ func sweepImages(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepModelCards(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.SageMakerConn(ctx)
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListModelCardsPagesWithContext(ctx, &sagemaker.ListModelCardsInput{}, func(page *sagemaker.ListModelCardsOutput, lastPage bool) bool {
+		for _, card := range page.ModelCardSummaries {
+			r := ResourceModelCard()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(card.ModelCardName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Model Card sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SageMaker Model Cards: %w", err))
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping SageMaker Model Cards: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepModelPackageGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
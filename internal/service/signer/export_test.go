@@ -5,6 +5,7 @@ package signer
 
 // Exports for use in tests only.
 var (
-	FindSigningJobByID       = findSigningJobByID
-	FindSigningProfileByName = findSigningProfileByName
+	FindSigningJobByID                  = findSigningJobByID
+	FindSigningProfileByName            = findSigningProfileByName
+	FindSigningProfilePermissionsByName = findSigningProfilePermissionsByName
 )
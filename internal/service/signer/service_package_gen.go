@@ -41,6 +41,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceSigningJob,
 			TypeName: "aws_signer_signing_job",
 		},
+		{
+			Factory:  ResourceSigningJobRevocation,
+			TypeName: "aws_signer_signing_job_revocation",
+		},
 		{
 			Factory:  ResourceSigningProfile,
 			TypeName: "aws_signer_signing_profile",
@@ -53,6 +57,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceSigningProfilePermission,
 			TypeName: "aws_signer_signing_profile_permission",
 		},
+		{
+			Factory:  ResourceSigningProfilePermissions,
+			TypeName: "aws_signer_signing_profile_permissions",
+		},
 	}
 }
 
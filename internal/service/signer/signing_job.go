@@ -512,10 +512,6 @@ func findSigningJobByID(ctx context.Context, conn *signer.Client, id string) (*s
 
 	out, err := conn.DescribeSigningJob(ctx, in)
 
-	if err != nil {
-		return nil, err
-	}
-
 	var nfe *types.ResourceNotFoundException
 	if errors.As(err, &nfe) {
 		return nil, &retry.NotFoundError{
@@ -524,6 +520,10 @@ func findSigningJobByID(ctx context.Context, conn *signer.Client, id string) (*s
 		}
 	}
 
+	if err != nil {
+		return nil, err
+	}
+
 	if out == nil {
 		return nil, tfresource.NewEmptyResultError(in)
 	}
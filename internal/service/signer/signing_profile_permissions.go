@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_signer_signing_profile_permissions")
+func ResourceSigningProfilePermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSigningProfilePermissionsCreate,
+		ReadWithoutTimeout:   resourceSigningProfilePermissionsRead,
+		UpdateWithoutTimeout: resourceSigningProfilePermissionsUpdate,
+		DeleteWithoutTimeout: resourceSigningProfilePermissionsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"permission": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"signer:StartSigningJob",
+								"signer:GetSigningProfile",
+								"signer:RevokeSignature"},
+								false),
+						},
+						"principal": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"profile_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(10, 10),
+						},
+						"statement_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[a-zA-Z0-9-_]{1,64}$`), "must be alphanumeric with max length of 64 characters"),
+						},
+					},
+				},
+			},
+			"profile_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 64),
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSigningProfilePermissionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	profileName := d.Get("profile_name").(string)
+
+	conns.GlobalMutexKV.Lock(profileName)
+	defer conns.GlobalMutexKV.Unlock(profileName)
+
+	if err := addSigningProfilePermissions(ctx, conn, profileName, d.Get("permission").(*schema.Set).List()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Signer Signing Profile Permissions (%s): %s", profileName, err)
+	}
+
+	d.SetId(profileName)
+
+	return append(diags, resourceSigningProfilePermissionsRead(ctx, d, meta)...)
+}
+
+func resourceSigningProfilePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	output, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findSigningProfilePermissionsByName(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Signer Signing Profile Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Signer Signing Profile Permissions (%s): %s", d.Id(), err)
+	}
+
+	out := output.(*signer.ListProfilePermissionsOutput)
+
+	if err := d.Set("permission", flattenSigningProfilePermissions(out.Permissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting permission: %s", err)
+	}
+	d.Set("profile_name", d.Id())
+	d.Set("revision_id", out.RevisionId)
+
+	return diags
+}
+
+func resourceSigningProfilePermissionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	profileName := d.Id()
+
+	conns.GlobalMutexKV.Lock(profileName)
+	defer conns.GlobalMutexKV.Unlock(profileName)
+
+	o, n := d.GetChange("permission")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	// Statements are immutable, so changed statements are removed and then re-added.
+	if err := removeSigningProfilePermissions(ctx, conn, profileName, os.Difference(ns).List()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Signer Signing Profile Permissions (%s): %s", profileName, err)
+	}
+
+	if err := addSigningProfilePermissions(ctx, conn, profileName, ns.Difference(os).List()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Signer Signing Profile Permissions (%s): %s", profileName, err)
+	}
+
+	return append(diags, resourceSigningProfilePermissionsRead(ctx, d, meta)...)
+}
+
+func resourceSigningProfilePermissionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SignerClient(ctx)
+
+	profileName := d.Id()
+
+	conns.GlobalMutexKV.Lock(profileName)
+	defer conns.GlobalMutexKV.Unlock(profileName)
+
+	log.Printf("[DEBUG] Deleting Signer Signing Profile Permissions: %s", d.Id())
+	err := removeSigningProfilePermissions(ctx, conn, profileName, d.Get("permission").(*schema.Set).List())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Signer Signing Profile Permissions (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func addSigningProfilePermissions(ctx context.Context, conn *signer.Client, profileName string, tfList []interface{}) error {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	revisionID, err := findSigningProfilePermissionsRevisionID(ctx, conn, profileName)
+
+	if err != nil {
+		return err
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		input := &signer.AddProfilePermissionInput{
+			Action:      aws.String(tfMap["action"].(string)),
+			Principal:   aws.String(tfMap["principal"].(string)),
+			ProfileName: aws.String(profileName),
+			RevisionId:  aws.String(revisionID),
+			StatementId: aws.String(tfMap["statement_id"].(string)),
+		}
+
+		if v, ok := tfMap["profile_version"].(string); ok && v != "" {
+			input.ProfileVersion = aws.String(v)
+		}
+
+		// Retry for IAM eventual consistency.
+		outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+			func() (interface{}, error) {
+				return conn.AddProfilePermission(ctx, input)
+			},
+			func(err error) (bool, error) {
+				var ce *types.ConflictException
+				if errors.As(err, &ce) {
+					// The policy was changed concurrently, so retry against its current revision.
+					currentRevisionID, findErr := findSigningProfilePermissionsRevisionID(ctx, conn, profileName)
+
+					if findErr != nil {
+						return false, findErr
+					}
+
+					input.RevisionId = aws.String(currentRevisionID)
+
+					return true, err
+				}
+
+				var nfe *types.ResourceNotFoundException
+				if errors.As(err, &nfe) {
+					return true, err
+				}
+
+				return false, err
+			},
+		)
+
+		if err != nil {
+			return err
+		}
+
+		revisionID = aws.ToString(outputRaw.(*signer.AddProfilePermissionOutput).RevisionId)
+	}
+
+	return nil
+}
+
+func removeSigningProfilePermissions(ctx context.Context, conn *signer.Client, profileName string, tfList []interface{}) error {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	output, err := findSigningProfilePermissionsByName(ctx, conn, profileName)
+
+	if err != nil {
+		return err
+	}
+
+	revisionID := aws.ToString(output.RevisionId)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		statementID := tfMap["statement_id"].(string)
+
+		if getProfilePermission(output.Permissions, statementID) == (types.Permission{}) {
+			continue
+		}
+
+		out, err := conn.RemoveProfilePermission(ctx, &signer.RemoveProfilePermissionInput{
+			ProfileName: aws.String(profileName),
+			RevisionId:  aws.String(revisionID),
+			StatementId: aws.String(statementID),
+		})
+
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		revisionID = aws.ToString(out.RevisionId)
+	}
+
+	return nil
+}
+
+// findSigningProfilePermissionsRevisionID returns the revision ID of the profile's policy,
+// or an empty string if the profile has no permissions.
+func findSigningProfilePermissionsRevisionID(ctx context.Context, conn *signer.Client, profileName string) (string, error) {
+	output, err := findSigningProfilePermissionsByName(ctx, conn, profileName)
+
+	if tfresource.NotFound(err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.RevisionId), nil
+}
+
+func findSigningProfilePermissionsByName(ctx context.Context, conn *signer.Client, profileName string) (*signer.ListProfilePermissionsOutput, error) {
+	input := &signer.ListProfilePermissionsInput{
+		ProfileName: aws.String(profileName),
+	}
+	output := &signer.ListProfilePermissionsOutput{}
+
+	for {
+		page, err := conn.ListProfilePermissions(ctx, input)
+
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		output.Permissions = append(output.Permissions, page.Permissions...)
+		output.PolicySizeBytes = page.PolicySizeBytes
+		output.RevisionId = page.RevisionId
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	if len(output.Permissions) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenSigningProfilePermissions(apiObjects []types.Permission) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"action":          aws.ToString(apiObject.Action),
+			"principal":       aws.ToString(apiObject.Principal),
+			"profile_version": aws.ToString(apiObject.ProfileVersion),
+			"statement_id":    aws.ToString(apiObject.StatementId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsigner "github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSignerSigningProfilePermissions_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_signer_signing_profile_permissions.test"
+	profileName := fmt.Sprintf("tf_acc_spp_%s", sdkacctest.RandString(53))
+
+	var v signer.ListProfilePermissionsOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "Notation-OCI-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSigningProfilePermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningProfilePermissionsConfig_basic(profileName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningProfilePermissionsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "profile_name", profileName),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						"action":       "signer:GetSigningProfile",
+						"statement_id": "get",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSigningProfilePermissionsConfig_updated(profileName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningProfilePermissionsExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "permission.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						"action":       "signer:StartSigningJob",
+						"statement_id": "start",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "permission.*", map[string]string{
+						"action":       "signer:RevokeSignature",
+						"statement_id": "revoke",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSigningProfilePermissionsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SignerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_signer_signing_profile_permissions" {
+				continue
+			}
+
+			_, err := tfsigner.FindSigningProfilePermissionsByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Signer Signing Profile Permissions %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSigningProfilePermissionsExists(ctx context.Context, n string, v *signer.ListProfilePermissionsOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SignerClient(ctx)
+
+		output, err := tfsigner.FindSigningProfilePermissionsByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSigningProfilePermissionsConfig_base(profileName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_signer_signing_profile" "test" {
+  platform_id = "Notation-OCI-SHA384-ECDSA"
+  name        = %[1]q
+
+  signature_validity_period {
+    value = 5
+    type  = "YEARS"
+  }
+}
+`, profileName)
+}
+
+func testAccSigningProfilePermissionsConfig_basic(profileName string) string {
+	return acctest.ConfigCompose(testAccSigningProfilePermissionsConfig_base(profileName), `
+resource "aws_signer_signing_profile_permissions" "test" {
+  profile_name = aws_signer_signing_profile.test.name
+
+  permission {
+    action       = "signer:GetSigningProfile"
+    principal    = data.aws_caller_identity.current.account_id
+    statement_id = "get"
+  }
+}
+`)
+}
+
+func testAccSigningProfilePermissionsConfig_updated(profileName string) string {
+	return acctest.ConfigCompose(testAccSigningProfilePermissionsConfig_base(profileName), `
+resource "aws_signer_signing_profile_permissions" "test" {
+  profile_name = aws_signer_signing_profile.test.name
+
+  permission {
+    action       = "signer:StartSigningJob"
+    principal    = data.aws_caller_identity.current.account_id
+    statement_id = "start"
+  }
+
+  permission {
+    action       = "signer:RevokeSignature"
+    principal    = data.aws_caller_identity.current.account_id
+    statement_id = "revoke"
+  }
+}
+`)
+}
@@ -10,50 +10,62 @@ import (
 	"log"
 	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 var (
 	platformApplicationSchema = map[string]*schema.Schema{
 		"apple_platform_bundle_id": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"apple_platform_team_id", "platform_principal"},
+			ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z.-]+$`), "must only include alphanumeric characters, hyphens (-), and periods (.)"),
 		},
 		"apple_platform_team_id": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"apple_platform_bundle_id", "platform_principal"},
+			ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z]{10}$`), "must be 10 alphanumeric characters"),
 		},
 		"arn": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
 		"event_delivery_failure_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"event_endpoint_created_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"event_endpoint_deleted_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"event_endpoint_updated_topic_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"failure_feedback_role_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"name": {
 			Type:     schema.TypeString,
@@ -76,12 +88,14 @@ var (
 			Sensitive: true,
 		},
 		"success_feedback_role_arn": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
 		},
 		"success_feedback_sample_rate": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([0-9]|[1-9][0-9]|100)$`), "must be an integer between 0 and 100"),
 		},
 	}
 
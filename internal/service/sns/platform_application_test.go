@@ -466,6 +466,32 @@ func TestAccSNSPlatformApplication_basicApnsWithTokenCredentials(t *testing.T) {
 	}
 }
 
+func TestAccSNSPlatformApplication_apnsTokenCredentialsValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := fmt.Sprintf("tf-acc-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sns.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlatformApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPlatformApplicationConfig_apnsTokenCredentialsValidation(name, `apple_platform_team_id = "123"`),
+				ExpectError: regexache.MustCompile(`must be 10 alphanumeric characters`),
+			},
+			{
+				Config:      testAccPlatformApplicationConfig_apnsTokenCredentialsValidation(name, `apple_platform_team_id = "1111111111"`),
+				ExpectError: regexache.MustCompile(`all of .+apple_platform_bundle_id.+ must be specified`),
+			},
+			{
+				Config:      testAccPlatformApplicationConfig_apnsTokenCredentialsValidation(name, `apple_platform_bundle_id = "com.bundle name"`),
+				ExpectError: regexache.MustCompile(`must only include alphanumeric characters, hyphens`),
+			},
+		},
+	})
+}
+
 func testAccCheckPlatformApplicationExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -653,3 +679,16 @@ resource "aws_sns_platform_application" "test" {
 }
 `, name, platform.Name, platform.Credential, platform.Principal, applePlatformTeamId, applePlatformBundleId)
 }
+
+func testAccPlatformApplicationConfig_apnsTokenCredentialsValidation(name, attributes string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_platform_application" "test" {
+  name                = %[1]q
+  platform            = "APNS_SANDBOX"
+  platform_credential = "signing-key"
+  platform_principal  = "KEYID12345"
+
+  %[2]s
+}
+`, name, attributes)
+}
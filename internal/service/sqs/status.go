@@ -8,9 +8,9 @@ import (
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/service/sqs"
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func statusQueueState(ctx context.Context, conn *sqs.SQS, url string) retry.StateRefreshFunc {
@@ -51,7 +51,7 @@ func statusQueueAttributeState(ctx context.Context, conn *sqs.SQS, url string, e
 
 				switch k {
 				case sqs.QueueAttributeNamePolicy:
-					equivalent, err := awspolicy.PoliciesAreEquivalent(g, e)
+					equivalent, err := verify.PoliciesAreEquivalent(g, e)
 
 					if err != nil {
 						return queueAttributeStateNotEqual
@@ -49,6 +49,10 @@ func ResourceDocument() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"approved_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -72,6 +76,10 @@ func ResourceDocument() *schema.Resource {
 								validation.StringLenBetween(3, 128),
 							),
 						},
+						"source_hash": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"values": {
 							Type:     schema.TypeList,
 							MinItems: 1,
@@ -164,6 +172,10 @@ func ResourceDocument() *schema.Resource {
 					},
 				},
 			},
+			"pending_review_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"permissions": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -176,6 +188,30 @@ func ResourceDocument() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"review_information": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reviewed_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reviewer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"review_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"schema_version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -225,7 +261,8 @@ func ResourceDocument() *schema.Resource {
 					}
 				}
 
-				if d.HasChange("content") {
+				// Changes to attachments, including their source_hash, create a new document version.
+				if d.HasChanges("attachments_source", "content") {
 					if err := d.SetNewComputed("default_version"); err != nil {
 						return err
 					}
@@ -334,6 +371,7 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta inte
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("document/%s", aws.StringValue(doc.Name)),
 	}.String()
+	d.Set("approved_version", doc.ApprovedVersion)
 	d.Set("arn", arn)
 	d.Set("created_date", aws.TimeValue(doc.CreatedDate).Format(time.RFC3339))
 	d.Set("default_version", doc.DefaultVersion)
@@ -349,7 +387,12 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("parameter", flattenDocumentParameters(doc.Parameters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting parameter: %s", err)
 	}
+	d.Set("pending_review_version", doc.PendingReviewVersion)
 	d.Set("platform_types", aws.StringValueSlice(doc.PlatformTypes))
+	if err := d.Set("review_information", flattenReviewInformations(doc.ReviewInformation)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting review_information: %s", err)
+	}
+	d.Set("review_status", doc.ReviewStatus)
 	d.Set("schema_version", doc.SchemaVersion)
 	d.Set("status", doc.Status)
 	d.Set("target_type", doc.TargetType)
@@ -455,7 +498,7 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		// Update for schema version 1.x is not allowed.
 		isSchemaVersion1, _ := regexp.MatchString(`^1[.][0-9]$`, d.Get("schema_version").(string))
 
-		if d.HasChange("content") || !isSchemaVersion1 {
+		if d.HasChanges("attachments_source", "content") || !isSchemaVersion1 {
 			input := &ssm.UpdateDocumentInput{
 				Content:         aws.String(d.Get("content").(string)),
 				DocumentFormat:  aws.String(d.Get("document_format").(string)),
@@ -479,7 +522,12 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 			output, err := conn.UpdateDocumentWithContext(ctx, input)
 
-			if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDuplicateDocumentContent) {
+			if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDuplicateDocumentContent) && d.HasChange("attachments_source") {
+				// New document versions require new content, so an attachments change alone can't be applied.
+				// Keep the prior attachments_source in state so that the change is planned again.
+				d.Partial(true)
+				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s): attachments_source changed without a change to content: %s", d.Id(), err)
+			} else if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDuplicateDocumentContent) {
 				defaultVersion = d.Get("latest_version").(string)
 			} else if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s): %s", d.Id(), err)
@@ -727,3 +775,43 @@ func flattenDocumentParameters(apiObjects []*ssm.DocumentParameter) []interface{
 
 	return tfList
 }
+
+func flattenReviewInformation(apiObject *ssm.ReviewInformation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ReviewedTime; v != nil {
+		tfMap["reviewed_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Reviewer; v != nil {
+		tfMap["reviewer"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenReviewInformations(apiObjects []*ssm.ReviewInformation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenReviewInformation(apiObject))
+	}
+
+	return tfList
+}
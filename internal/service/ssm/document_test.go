@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -429,6 +430,48 @@ func TestAccSSMDocument_package(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_attachmentsSourceHash(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_ssm_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_attachmentsSourceHash(rName, rInt, "hash1", "0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "approved_version", ""),
+					resource.TestCheckResourceAttr(resourceName, "attachments_source.0.source_hash", "hash1"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pending_review_version", ""),
+					resource.TestCheckResourceAttr(resourceName, "review_information.#", "0"),
+				),
+			},
+			{
+				// SSM rejects a new document version with unchanged content.
+				Config:      testAccDocumentConfig_attachmentsSourceHash(rName, rInt, "hash2", "0.1"),
+				ExpectError: regexache.MustCompile(`attachments_source changed without a change to content`),
+			},
+			{
+				Config: testAccDocumentConfig_attachmentsSourceHash(rName, rInt, "hash2", "0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attachments_source.0.source_hash", "hash2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_SchemaVersion_1(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1056,7 +1099,7 @@ DOC
 `, rName))
 }
 
-func testAccDocumentConfig_typePackage(rName string, rInt int) string {
+func testAccDocumentConfig_packageBase(rName string, rInt int) string {
 	return fmt.Sprintf(`
 resource "aws_iam_instance_profile" "test" {
   name = %[1]q
@@ -1126,7 +1169,11 @@ resource "aws_s3_object" "test" {
   source       = "test-fixtures/ssm-doc-acc-test.zip"
   content_type = "binary/octet-stream"
 }
+`, rName, rInt)
+}
 
+func testAccDocumentConfig_typePackage(rName string, rInt int) string {
+	return acctest.ConfigCompose(testAccDocumentConfig_packageBase(rName, rInt), fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
   name          = %[1]q
   document_type = "Package"
@@ -1163,7 +1210,49 @@ DOC
 
   depends_on = [aws_iam_role_policy.test]
 }
-`, rName, rInt)
+`, rName))
+}
+
+func testAccDocumentConfig_attachmentsSourceHash(rName string, rInt int, sourceHash, version string) string {
+	return acctest.ConfigCompose(testAccDocumentConfig_packageBase(rName, rInt), fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Package"
+
+  attachments_source {
+    key         = "SourceUrl"
+    values      = ["s3://${aws_s3_object.test.bucket}"]
+    source_hash = %[2]q
+  }
+
+  content = <<DOC
+{
+  "description": "Systems Manager Package Document Test",
+  "schemaVersion": "2.0",
+  "version": %[3]q,
+  "assumeRole": "${aws_iam_role.test.arn}",
+  "files": {
+    "test.zip": {
+      "checksums": {
+        "sha256": "${filesha256("test-fixtures/ssm-doc-acc-test.zip")}"
+      }
+    }
+  },
+  "packages": {
+    "amazon": {
+      "_any": {
+        "x86_64": {
+          "file": "${aws_s3_object.test.key}"
+        }
+      }
+    }
+  }
+}
+DOC
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, sourceHash, version))
 }
 
 func testAccDocumentConfig_typeSession(rName string) string {
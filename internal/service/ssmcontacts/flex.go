@@ -197,3 +197,177 @@ func flattenContactTargetInfo(contactTargetInfo *types.ContactTargetInfo) []inte
 
 	return result
 }
+
+func expandRecurrenceSettings(recurrence []interface{}) *types.RecurrenceSettings {
+	if len(recurrence) == 0 || recurrence[0] == nil {
+		return nil
+	}
+
+	m := recurrence[0].(map[string]interface{})
+
+	recurrenceSettings := &types.RecurrenceSettings{
+		NumberOfOnCalls:      aws.Int32(int32(m["number_of_on_calls"].(int))),
+		RecurrenceMultiplier: aws.Int32(int32(m["recurrence_multiplier"].(int))),
+	}
+
+	if v, ok := m["daily_settings"].([]interface{}); ok && len(v) > 0 {
+		for _, dailySetting := range v {
+			if dailySetting == nil {
+				continue
+			}
+
+			recurrenceSettings.DailySettings = append(recurrenceSettings.DailySettings, *expandHandOffTime(dailySetting.(map[string]interface{})))
+		}
+	}
+
+	if v, ok := m["monthly_settings"].([]interface{}); ok && len(v) > 0 {
+		for _, monthlySetting := range v {
+			if monthlySetting == nil {
+				continue
+			}
+
+			ms := monthlySetting.(map[string]interface{})
+
+			recurrenceSettings.MonthlySettings = append(recurrenceSettings.MonthlySettings, types.MonthlySetting{
+				DayOfMonth:  aws.Int32(int32(ms["day_of_month"].(int))),
+				HandOffTime: expandHandOffTimeList(ms["hand_off_time"].([]interface{})),
+			})
+		}
+	}
+
+	if v, ok := m["shift_coverages"].([]interface{}); ok && len(v) > 0 {
+		recurrenceSettings.ShiftCoverages = make(map[string][]types.CoverageTime)
+
+		for _, shiftCoverage := range v {
+			if shiftCoverage == nil {
+				continue
+			}
+
+			sc := shiftCoverage.(map[string]interface{})
+
+			var coverageTimes []types.CoverageTime
+			for _, coverageTime := range sc["coverage_times"].([]interface{}) {
+				if coverageTime == nil {
+					continue
+				}
+
+				ct := coverageTime.(map[string]interface{})
+
+				coverageTimes = append(coverageTimes, types.CoverageTime{
+					End:   expandHandOffTimeList(ct["end"].([]interface{})),
+					Start: expandHandOffTimeList(ct["start"].([]interface{})),
+				})
+			}
+
+			recurrenceSettings.ShiftCoverages[sc["map_block_key"].(string)] = coverageTimes
+		}
+	}
+
+	if v, ok := m["weekly_settings"].([]interface{}); ok && len(v) > 0 {
+		for _, weeklySetting := range v {
+			if weeklySetting == nil {
+				continue
+			}
+
+			ws := weeklySetting.(map[string]interface{})
+
+			recurrenceSettings.WeeklySettings = append(recurrenceSettings.WeeklySettings, types.WeeklySetting{
+				DayOfWeek:   types.DayOfWeek(ws["day_of_week"].(string)),
+				HandOffTime: expandHandOffTimeList(ws["hand_off_time"].([]interface{})),
+			})
+		}
+	}
+
+	return recurrenceSettings
+}
+
+func flattenRecurrenceSettings(recurrenceSettings *types.RecurrenceSettings) []interface{} {
+	if recurrenceSettings == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"number_of_on_calls":    aws.ToInt32(recurrenceSettings.NumberOfOnCalls),
+		"recurrence_multiplier": aws.ToInt32(recurrenceSettings.RecurrenceMultiplier),
+	}
+
+	var dailySettings []interface{}
+	for _, dailySetting := range recurrenceSettings.DailySettings {
+		dailySetting := dailySetting
+		dailySettings = append(dailySettings, flattenHandOffTime(&dailySetting))
+	}
+	m["daily_settings"] = dailySettings
+
+	var monthlySettings []interface{}
+	for _, monthlySetting := range recurrenceSettings.MonthlySettings {
+		monthlySettings = append(monthlySettings, map[string]interface{}{
+			"day_of_month":  aws.ToInt32(monthlySetting.DayOfMonth),
+			"hand_off_time": flattenHandOffTimeList(monthlySetting.HandOffTime),
+		})
+	}
+	m["monthly_settings"] = monthlySettings
+
+	// Shift coverages are returned as a map keyed by day of week, so flatten them in day order.
+	var shiftCoverages []interface{}
+	for _, dayOfWeek := range types.DayOfWeek("").Values() {
+		coverageTimes, ok := recurrenceSettings.ShiftCoverages[string(dayOfWeek)]
+		if !ok {
+			continue
+		}
+
+		var cts []interface{}
+		for _, coverageTime := range coverageTimes {
+			cts = append(cts, map[string]interface{}{
+				"end":   flattenHandOffTimeList(coverageTime.End),
+				"start": flattenHandOffTimeList(coverageTime.Start),
+			})
+		}
+
+		shiftCoverages = append(shiftCoverages, map[string]interface{}{
+			"coverage_times": cts,
+			"map_block_key":  string(dayOfWeek),
+		})
+	}
+	m["shift_coverages"] = shiftCoverages
+
+	var weeklySettings []interface{}
+	for _, weeklySetting := range recurrenceSettings.WeeklySettings {
+		weeklySettings = append(weeklySettings, map[string]interface{}{
+			"day_of_week":   string(weeklySetting.DayOfWeek),
+			"hand_off_time": flattenHandOffTimeList(weeklySetting.HandOffTime),
+		})
+	}
+	m["weekly_settings"] = weeklySettings
+
+	return []interface{}{m}
+}
+
+func expandHandOffTime(handOffTime map[string]interface{}) *types.HandOffTime {
+	return &types.HandOffTime{
+		HourOfDay:    int32(handOffTime["hour_of_day"].(int)),
+		MinuteOfHour: int32(handOffTime["minute_of_hour"].(int)),
+	}
+}
+
+func expandHandOffTimeList(handOffTime []interface{}) *types.HandOffTime {
+	if len(handOffTime) == 0 || handOffTime[0] == nil {
+		return nil
+	}
+
+	return expandHandOffTime(handOffTime[0].(map[string]interface{}))
+}
+
+func flattenHandOffTime(handOffTime *types.HandOffTime) map[string]interface{} {
+	return map[string]interface{}{
+		"hour_of_day":    handOffTime.HourOfDay,
+		"minute_of_hour": handOffTime.MinuteOfHour,
+	}
+}
+
+func flattenHandOffTimeList(handOffTime *types.HandOffTime) []interface{} {
+	if handOffTime == nil {
+		return nil
+	}
+
+	return []interface{}{flattenHandOffTime(handOffTime)}
+}
@@ -5,7 +5,9 @@ package ssmcontacts
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -40,3 +42,20 @@ func setPlanResourceData(d *schema.ResourceData, getContactOutput *ssmcontacts.G
 
 	return nil
 }
+
+func setRotationResourceData(d *schema.ResourceData, out *ssmcontacts.GetRotationOutput) error {
+	d.Set("arn", out.RotationArn)
+	d.Set("contact_ids", out.ContactIds)
+	d.Set("name", out.Name)
+	if err := d.Set("recurrence", flattenRecurrenceSettings(out.Recurrence)); err != nil {
+		return fmt.Errorf("setting recurrence: %w", err)
+	}
+	if out.StartTime != nil {
+		d.Set("start_time", aws.ToTime(out.StartTime).UTC().Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("time_zone_id", out.TimeZoneId)
+
+	return nil
+}
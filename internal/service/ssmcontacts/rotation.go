@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssmcontacts_rotation", name="Rotation")
+// @Tags(identifierAttribute="id")
+func ResourceRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRotationCreate,
+		ReadWithoutTimeout:   resourceRotationRead,
+		UpdateWithoutTimeout: resourceRotationUpdate,
+		DeleteWithoutTimeout: resourceRotationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"recurrence": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     handOffTimeSchema(),
+						},
+						"monthly_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_month": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 31),
+									},
+									"hand_off_time": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     handOffTimeSchema(),
+									},
+								},
+							},
+						},
+						"number_of_on_calls": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"recurrence_multiplier": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"shift_coverages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"coverage_times": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem:     handOffTimeSchema(),
+												},
+												"start": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem:     handOffTimeSchema(),
+												},
+											},
+										},
+									},
+									"map_block_key": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.DayOfWeek](),
+									},
+								},
+							},
+						},
+						"weekly_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"day_of_week": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.DayOfWeek](),
+									},
+									"hand_off_time": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     handOffTimeSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"time_zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func handOffTimeSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hour_of_day": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 23),
+			},
+			"minute_of_hour": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 59),
+			},
+		},
+	}
+}
+
+const (
+	ResNameRotation = "Rotation"
+)
+
+func resourceRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsClient(ctx)
+
+	name := d.Get("name").(string)
+	in := &ssmcontacts.CreateRotationInput{
+		ContactIds: flex.ExpandStringValueList(d.Get("contact_ids").([]interface{})),
+		Name:       aws.String(name),
+		Recurrence: expandRecurrenceSettings(d.Get("recurrence").([]interface{})),
+		Tags:       getTagsIn(ctx),
+		TimeZoneId: aws.String(d.Get("time_zone_id").(string)),
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		in.StartTime = aws.Time(v)
+	}
+
+	out, err := conn.CreateRotation(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionCreating, ResNameRotation, name, err)
+	}
+
+	d.SetId(aws.ToString(out.RotationArn))
+
+	return resourceRotationRead(ctx, d, meta)
+}
+
+func resourceRotationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsClient(ctx)
+
+	out, err := findRotationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSMContacts Rotation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, ResNameRotation, d.Id(), err)
+	}
+
+	if err := setRotationResourceData(d, out); err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, ResNameRotation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceRotationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsClient(ctx)
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// Recurrence is required on every update.
+		in := &ssmcontacts.UpdateRotationInput{
+			ContactIds: flex.ExpandStringValueList(d.Get("contact_ids").([]interface{})),
+			Recurrence: expandRecurrenceSettings(d.Get("recurrence").([]interface{})),
+			RotationId: aws.String(d.Id()),
+			TimeZoneId: aws.String(d.Get("time_zone_id").(string)),
+		}
+
+		if v, ok := d.GetOk("start_time"); ok {
+			v, _ := time.Parse(time.RFC3339, v.(string))
+			in.StartTime = aws.Time(v)
+		}
+
+		log.Printf("[DEBUG] Updating SSMContacts Rotation (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateRotation(ctx, in)
+		if err != nil {
+			return create.DiagError(names.SSMContacts, create.ErrActionUpdating, ResNameRotation, d.Id(), err)
+		}
+	}
+
+	return resourceRotationRead(ctx, d, meta)
+}
+
+func resourceRotationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsClient(ctx)
+
+	log.Printf("[INFO] Deleting SSMContacts Rotation %s", d.Id())
+
+	_, err := conn.DeleteRotation(ctx, &ssmcontacts.DeleteRotationInput{
+		RotationId: aws.String(d.Id()),
+	})
+
+	var nfe *types.ResourceNotFoundException
+	if errors.As(err, &nfe) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionDeleting, ResNameRotation, d.Id(), err)
+	}
+
+	return nil
+}
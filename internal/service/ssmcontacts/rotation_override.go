@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ssmcontacts_rotation_override", name="Rotation Override")
+func ResourceRotationOverride() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRotationOverrideCreate,
+		ReadWithoutTimeout:   resourceRotationOverrideRead,
+		DeleteWithoutTimeout: resourceRotationOverrideDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"end_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"new_contact_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rotation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rotation_override_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+		},
+	}
+}
+
+const (
+	ResNameRotationOverride = "Rotation Override"
+
+	rotationOverrideResourceIDPartCount = 2
+)
+
+func resourceRotationOverrideCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsClient(ctx)
+
+	rotationID := d.Get("rotation_id").(string)
+	startTime, _ := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	endTime, _ := time.Parse(time.RFC3339, d.Get("end_time").(string))
+	in := &ssmcontacts.CreateRotationOverrideInput{
+		EndTime:       aws.Time(endTime),
+		NewContactIds: flex.ExpandStringValueList(d.Get("new_contact_ids").([]interface{})),
+		RotationId:    aws.String(rotationID),
+		StartTime:     aws.Time(startTime),
+	}
+
+	out, err := conn.CreateRotationOverride(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionCreating, ResNameRotationOverride, rotationID, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{rotationID, aws.ToString(out.RotationOverrideId)}, rotationOverrideResourceIDPartCount, false)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionCreating, ResNameRotationOverride, rotationID, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRotationOverrideRead(ctx, d, meta)
+}
+
+func resourceRotationOverrideRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), rotationOverrideResourceIDPartCount, false)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, ResNameRotationOverride, d.Id(), err)
+	}
+
+	out, err := findRotationOverrideByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSMContacts Rotation Override (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, ResNameRotationOverride, d.Id(), err)
+	}
+
+	d.Set("end_time", aws.ToTime(out.EndTime).UTC().Format(time.RFC3339))
+	d.Set("new_contact_ids", out.NewContactIds)
+	d.Set("rotation_id", parts[0])
+	d.Set("rotation_override_id", out.RotationOverrideId)
+	d.Set("start_time", aws.ToTime(out.StartTime).UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceRotationOverrideDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), rotationOverrideResourceIDPartCount, false)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionDeleting, ResNameRotationOverride, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting SSMContacts Rotation Override %s", d.Id())
+
+	_, err = conn.DeleteRotationOverride(ctx, &ssmcontacts.DeleteRotationOverrideInput{
+		RotationId:         aws.String(parts[0]),
+		RotationOverrideId: aws.String(parts[1]),
+	})
+
+	var nfe *types.ResourceNotFoundException
+	if errors.As(err, &nfe) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionDeleting, ResNameRotationOverride, d.Id(), err)
+	}
+
+	return nil
+}
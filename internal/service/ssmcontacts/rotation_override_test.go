@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testRotationOverride_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation_override.test"
+	startTime := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour).Format(time.RFC3339)
+	endTime := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationOverrideDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationOverrideConfig_basic(rName, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationOverrideExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "end_time", endTime),
+					resource.TestCheckResourceAttr(resourceName, "new_contact_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "new_contact_ids.0", "aws_ssmcontacts_contact.test_contact_two", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "rotation_id", "aws_ssmcontacts_rotation.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotation_override_id"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", startTime),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRotationOverrideConfig_rotation(rName),
+				Check:  testAccCheckRotationOverrideDestroy(ctx),
+			},
+		},
+	})
+}
+
+func testAccCheckRotationOverrideExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotationOverride, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotationOverride, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsClient(ctx)
+
+		_, err := conn.GetRotationOverride(ctx, &ssmcontacts.GetRotationOverrideInput{
+			RotationId:         aws.String(rs.Primary.Attributes["rotation_id"]),
+			RotationOverrideId: aws.String(rs.Primary.Attributes["rotation_override_id"]),
+		})
+
+		if err != nil {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotationOverride, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRotationOverrideDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmcontacts_rotation_override" {
+				continue
+			}
+
+			parts := strings.Split(rs.Primary.ID, ",")
+			if len(parts) != 2 {
+				return fmt.Errorf("unexpected ID format: %s", rs.Primary.ID)
+			}
+
+			_, err := conn.GetRotationOverride(ctx, &ssmcontacts.GetRotationOverrideInput{
+				RotationId:         aws.String(parts[0]),
+				RotationOverrideId: aws.String(parts[1]),
+			})
+			if err != nil {
+				// Getting resources may return validation exception when the replication set has been destroyed
+				var ve *types.ValidationException
+				if errors.As(err, &ve) {
+					continue
+				}
+
+				var nfe *types.ResourceNotFoundException
+				if errors.As(err, &nfe) {
+					continue
+				}
+
+				return err
+			}
+
+			return create.Error(names.SSMContacts, create.ErrActionCheckingDestroyed, tfssmcontacts.ResNameRotationOverride, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccRotationOverrideConfig_rotation(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPlanConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids = [
+    aws_ssmcontacts_contact.test_contact_one.arn,
+    aws_ssmcontacts_contact.test_contact_two.arn,
+  ]
+  name         = %[1]q
+  time_zone_id = "UTC"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+}
+`, rName))
+}
+
+func testAccRotationOverrideConfig_basic(rName, startTime, endTime string) string {
+	return acctest.ConfigCompose(
+		testAccRotationOverrideConfig_rotation(rName),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation_override" "test" {
+  rotation_id     = aws_ssmcontacts_rotation.test.arn
+  new_contact_ids = [aws_ssmcontacts_contact.test_contact_two.arn]
+  start_time      = %[1]q
+  end_time        = %[2]q
+}
+`, startTime, endTime))
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testRotation_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", "rotation/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "contact_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.0", "aws_ssmcontacts_contact.test_contact_one", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.0.hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.0.minute_of_hour", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.number_of_on_calls", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.recurrence_multiplier", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone_id", "Australia/Sydney"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// We need to explicitly test destroying this resource instead of just using CheckDestroy,
+				// because CheckDestroy will run after the replication set has been destroyed and destroying
+				// the replication set will destroy all other resources.
+				Config: testAccPlanConfig_none(rName),
+				Check:  testAccCheckRotationDestroy(ctx),
+			},
+		},
+	})
+}
+
+func testRotation_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssmcontacts.ResourceRotation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testRotation_updateRecurrence(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccContactPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.#", "0"),
+				),
+			},
+			{
+				Config: testAccRotationConfig_weekly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.0", "aws_ssmcontacts_contact.test_contact_two", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_ids.1", "aws_ssmcontacts_contact.test_contact_one", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.daily_settings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.recurrence_multiplier", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.day_of_week", "MON"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.hand_off_time.0.hour_of_day", "10"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.weekly_settings.0.hand_off_time.0.minute_of_hour", "30"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.0.map_block_key", "MON"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.0.coverage_times.0.start.0.hour_of_day", "9"),
+					resource.TestCheckResourceAttr(resourceName, "recurrence.0.shift_coverages.0.coverage_times.0.end.0.hour_of_day", "17"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPlanConfig_none(rName),
+				Check:  testAccCheckRotationDestroy(ctx),
+			},
+		},
+	})
+}
+
+func testAccCheckRotationExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotation, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotation, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsClient(ctx)
+
+		_, err := conn.GetRotation(ctx, &ssmcontacts.GetRotationInput{
+			RotationId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotation, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRotationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmcontacts_rotation" {
+				continue
+			}
+
+			_, err := conn.GetRotation(ctx, &ssmcontacts.GetRotationInput{
+				RotationId: aws.String(rs.Primary.ID),
+			})
+			if err != nil {
+				// Getting resources may return validation exception when the replication set has been destroyed
+				var ve *types.ValidationException
+				if errors.As(err, &ve) {
+					continue
+				}
+
+				var nfe *types.ResourceNotFoundException
+				if errors.As(err, &nfe) {
+					continue
+				}
+
+				return err
+			}
+
+			return create.Error(names.SSMContacts, create.ErrActionCheckingDestroyed, tfssmcontacts.ResNameRotation, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccRotationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPlanConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids  = [aws_ssmcontacts_contact.test_contact_one.arn]
+  name         = %[1]q
+  time_zone_id = "Australia/Sydney"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 1
+
+    daily_settings {
+      hour_of_day    = 9
+      minute_of_hour = 0
+    }
+  }
+}
+`, rName))
+}
+
+func testAccRotationConfig_weekly(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPlanConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation" "test" {
+  contact_ids = [
+    aws_ssmcontacts_contact.test_contact_two.arn,
+    aws_ssmcontacts_contact.test_contact_one.arn,
+  ]
+  name         = %[1]q
+  time_zone_id = "Australia/Sydney"
+
+  recurrence {
+    number_of_on_calls    = 1
+    recurrence_multiplier = 2
+
+    weekly_settings {
+      day_of_week = "MON"
+
+      hand_off_time {
+        hour_of_day    = 10
+        minute_of_hour = 30
+      }
+    }
+
+    shift_coverages {
+      map_block_key = "MON"
+
+      coverage_times {
+        start {
+          hour_of_day    = 9
+          minute_of_hour = 0
+        }
+
+        end {
+          hour_of_day    = 17
+          minute_of_hour = 0
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
@@ -59,6 +59,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ssmcontacts_plan",
 			Name:     "Plan",
 		},
+		{
+			Factory:  ResourceRotation,
+			TypeName: "aws_ssmcontacts_rotation",
+			Name:     "Rotation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
+		{
+			Factory:  ResourceRotationOverride,
+			TypeName: "aws_ssmcontacts_rotation_override",
+			Name:     "Rotation Override",
+		},
 	}
 }
 
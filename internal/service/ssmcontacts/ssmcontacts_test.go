@@ -51,6 +51,14 @@ func TestAccSSMContacts_serial(t *testing.T) {
 			"basic":             testPlanDataSource_basic,
 			"channelTargetInfo": testPlanDataSource_channelTargetInfo,
 		},
+		"Rotation Resource Tests": {
+			"basic":            testRotation_basic,
+			"disappears":       testRotation_disappears,
+			"updateRecurrence": testRotation_updateRecurrence,
+		},
+		"Rotation Override Resource Tests": {
+			"basic": testRotationOverride_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -54,12 +54,12 @@ func ResourceAccess() *schema.Resource {
 						"entry": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringLenBetween(0, 1024),
+							ValidateFunc: validHomeDirectoryMappingPath,
 						},
 						"target": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringLenBetween(0, 1024),
+							ValidateFunc: validHomeDirectoryMappingPath,
 						},
 					},
 				},
@@ -122,6 +122,8 @@ func ResourceAccess() *schema.Resource {
 				ValidateFunc: validServerID,
 			},
 		},
+
+		CustomizeDiff: customizeDiffHomeDirectoryMappings,
 	}
 }
 
@@ -66,13 +66,14 @@ func TestAccTransfer_serial(t *testing.T) {
 			"System":     testAccTag_system,
 		},
 		"User": {
-			"basic":                 testAccUser_basic,
-			"disappears":            testAccUser_disappears,
-			"tags":                  testAccUser_tags,
-			"HomeDirectoryMappings": testAccUser_homeDirectoryMappings,
-			"ModifyWithOptions":     testAccUser_modifyWithOptions,
-			"Posix":                 testAccUser_posix,
-			"UserNameValidation":    testAccUser_UserName_Validation,
+			"basic":                           testAccUser_basic,
+			"disappears":                      testAccUser_disappears,
+			"tags":                            testAccUser_tags,
+			"HomeDirectoryMappings":           testAccUser_homeDirectoryMappings,
+			"HomeDirectoryMappingsValidation": testAccUser_homeDirectoryMappingsValidation,
+			"ModifyWithOptions":               testAccUser_modifyWithOptions,
+			"Posix":                           testAccUser_posix,
+			"UserNameValidation":              testAccUser_UserName_Validation,
 		},
 	}
 
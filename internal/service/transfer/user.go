@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -61,12 +62,12 @@ func ResourceUser() *schema.Resource {
 						"entry": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringLenBetween(0, 1024),
+							ValidateFunc: validHomeDirectoryMappingPath,
 						},
 						"target": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringLenBetween(0, 1024),
+							ValidateFunc: validHomeDirectoryMappingPath,
 						},
 					},
 				},
@@ -131,7 +132,10 @@ func ResourceUser() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffHomeDirectoryMappings,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -380,6 +384,36 @@ func expandHomeDirectoryMappings(in []interface{}) []*transfer.HomeDirectoryMapE
 	return mappings
 }
 
+// customizeDiffHomeDirectoryMappings validates that logical home directory mapping entries are unique.
+func customizeDiffHomeDirectoryMappings(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("home_directory_mappings") {
+		return nil
+	}
+
+	entries := make(map[string]bool)
+
+	for _, tfMapRaw := range diff.Get("home_directory_mappings").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// Unknown values are read as the zero value.
+		entry := tfMap["entry"].(string)
+		if entry == "" {
+			continue
+		}
+
+		if entries[entry] {
+			return fmt.Errorf("home_directory_mappings entry %q is configured more than once", entry)
+		}
+
+		entries[entry] = true
+	}
+
+	return nil
+}
+
 func flattenHomeDirectoryMappings(mappings []*transfer.HomeDirectoryMapEntry) []interface{} {
 	l := make([]interface{}, len(mappings))
 	for i, m := range mappings {
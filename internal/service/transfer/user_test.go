@@ -297,6 +297,32 @@ func testAccUser_homeDirectoryMappings(t *testing.T) {
 	})
 }
 
+func testAccUser_homeDirectoryMappingsValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, transfer.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserConfig_homeDirectoryMappings(rName, "your-personal-report.pdf", "/bucket3/customized-reports/tftestuser.pdf"),
+				ExpectError: regexache.MustCompile(`must be an absolute path`),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappings(rName, "/your-personal-report.pdf", "bucket3/customized-reports/tftestuser.pdf"),
+				ExpectError: regexache.MustCompile(`must be an absolute path`),
+			},
+			{
+				Config:      testAccUserConfig_homeDirectoryMappingsUpdate(rName, "/your-personal-report.pdf", "/bucket3/customized-reports/tftestuser.pdf", "/your-personal-report.pdf", "/bucket3/customized-reports2/tftestuser.pdf"),
+				ExpectError: regexache.MustCompile(`home_directory_mappings entry "/your-personal-report.pdf" is configured more than once`),
+			},
+		},
+	})
+}
+
 func testAccCheckUserExists(ctx context.Context, n string, v *transfer.DescribedUser) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -31,3 +31,18 @@ func validUserName(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+func validHomeDirectoryMappingPath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// https://docs.aws.amazon.com/transfer/latest/userguide/API_HomeDirectoryMapEntry.html
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 1024 characters: %q", k, value))
+	}
+
+	if !regexache.MustCompile(`^/`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be an absolute path beginning with a forward slash (/): %q", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_translate_parallel_data", name="Parallel Data")
+func ResourceParallelData() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParallelDataCreate,
+		ReadWithoutTimeout:   resourceParallelDataRead,
+		UpdateWithoutTimeout: resourceParallelDataUpdate,
+		DeleteWithoutTimeout: resourceParallelDataDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_key": encryptionKeySchema(),
+			"imported_record_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"parallel_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(translate.ParallelDataFormat_Values(), false),
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^s3://[^/]+/.+$`), "must be in the format s3://bucket/key"),
+						},
+					},
+				},
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_language_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceParallelDataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	name := d.Get("name").(string)
+	input := &translate.CreateParallelDataInput{
+		ClientToken:        aws.String(id.UniqueId()),
+		Name:               aws.String(name),
+		ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionKey = expandEncryptionKey(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateParallelDataWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Translate Parallel Data (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Name))
+
+	if _, err := waitParallelDataCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Translate Parallel Data (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceParallelDataRead(ctx, d, meta)...)
+}
+
+func resourceParallelDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	parallelData, err := FindParallelDataByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Translate Parallel Data (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", parallelData.Arn)
+	d.Set("description", parallelData.Description)
+	if err := d.Set("encryption_key", flattenEncryptionKey(parallelData.EncryptionKey)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption_key: %s", err)
+	}
+	d.Set("imported_record_count", parallelData.ImportedRecordCount)
+	d.Set("name", parallelData.Name)
+	if err := d.Set("parallel_data_config", flattenParallelDataConfig(parallelData.ParallelDataConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parallel_data_config: %s", err)
+	}
+	d.Set("source_language_code", parallelData.SourceLanguageCode)
+	d.Set("target_language_codes", aws.StringValueSlice(parallelData.TargetLanguageCodes))
+
+	return diags
+}
+
+func resourceParallelDataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	// A change to source_hash re-imports the parallel data from Amazon S3.
+	input := &translate.UpdateParallelDataInput{
+		ClientToken:        aws.String(id.UniqueId()),
+		Name:               aws.String(d.Id()),
+		ParallelDataConfig: expandParallelDataConfig(d.Get("parallel_data_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if _, err := conn.UpdateParallelDataWithContext(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitParallelDataUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Translate Parallel Data (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceParallelDataRead(ctx, d, meta)...)
+}
+
+func resourceParallelDataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	log.Printf("[INFO] Deleting Translate Parallel Data: %s", d.Id())
+	_, err := conn.DeleteParallelDataWithContext(ctx, &translate.DeleteParallelDataInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Translate Parallel Data (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitParallelDataDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Translate Parallel Data (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindParallelDataByName(ctx context.Context, conn *translate.Translate, name string) (*translate.ParallelDataProperties, error) {
+	input := &translate.GetParallelDataInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetParallelDataWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ParallelDataProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ParallelDataProperties, nil
+}
+
+func statusParallelData(ctx context.Context, conn *translate.Translate, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusParallelDataLatestUpdateAttempt(ctx context.Context, conn *translate.Translate, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindParallelDataByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LatestUpdateAttemptStatus), nil
+	}
+}
+
+func waitParallelDataCreated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusCreating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataUpdated(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusUpdating},
+		Target:  []string{translate.ParallelDataStatusActive},
+		Refresh: statusParallelDataLatestUpdateAttempt(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitParallelDataDeleted(ctx context.Context, conn *translate.Translate, name string, timeout time.Duration) (*translate.ParallelDataProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{translate.ParallelDataStatusDeleting},
+		Target:  []string{},
+		Refresh: statusParallelData(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*translate.ParallelDataProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandParallelDataConfig(tfList []interface{}) *translate.ParallelDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &translate.ParallelDataConfig{
+		Format: aws.String(tfMap["format"].(string)),
+		S3Uri:  aws.String(tfMap["s3_uri"].(string)),
+	}
+}
+
+func flattenParallelDataConfig(apiObject *translate.ParallelDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"format": aws.StringValue(apiObject.Format),
+		"s3_uri": aws.StringValue(apiObject.S3Uri),
+	}}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/translate"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranslateParallelData_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, translate.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "en,fr\nHello,Bonjour\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "translate", fmt.Sprintf("parallel-data/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_data_config.0.s3_uri", fmt.Sprintf("s3://%s/parallel-data.csv", rName)),
+					resource.TestCheckResourceAttrPair(resourceName, "source_hash", "aws_s3_object.test", "etag"),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.0", "fr"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_hash"},
+			},
+			{
+				Config: testAccParallelDataConfig_basic(rName, "en,fr\nHello,Bonjour\nGoodbye,Au revoir\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "imported_record_count", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_hash", "aws_s3_object.test", "etag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateParallelData_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.ParallelDataProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_parallel_data.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, translate.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParallelDataDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParallelDataConfig_basic(rName, "en,fr\nHello,Bonjour\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParallelDataExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftranslate.ResourceParallelData(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckParallelDataDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_translate_parallel_data" {
+				continue
+			}
+
+			_, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Translate Parallel Data %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParallelDataExists(ctx context.Context, n string, v *translate.ParallelDataProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn(ctx)
+
+		output, err := tftranslate.FindParallelDataByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccParallelDataConfig_basic(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "parallel-data.csv"
+  content = %[2]q
+}
+
+resource "aws_translate_parallel_data" "test" {
+  name        = %[1]q
+  source_hash = aws_s3_object.test.etag
+
+  parallel_data_config {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName, content)
+}
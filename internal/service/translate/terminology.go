@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_translate_terminology", name="Terminology")
+func ResourceTerminology() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTerminologyPut,
+		ReadWithoutTimeout:   resourceTerminologyRead,
+		UpdateWithoutTimeout: resourceTerminologyPut,
+		DeleteWithoutTimeout: resourceTerminologyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"encryption_key": encryptionKeySchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_language_codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"term_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"terminology_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"terminology_data.0.content", "terminology_data.0.s3_uri"},
+						},
+						"directionality": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(translate.Directionality_Values(), false),
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(translate.TerminologyDataFormat_Values(), false),
+						},
+						"s3_uri": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"terminology_data.0.content", "terminology_data.0.s3_uri"},
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^s3://[^/]+/.+$`), "must be in the format s3://bucket/key"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 256),
+	validation.StringMatch(regexache.MustCompile(`^([0-9A-Za-z-]_?)+$`), "must contain only alphanumeric characters, hyphens and non-consecutive underscores"),
+)
+
+func encryptionKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Default:      translate.EncryptionKeyTypeKms,
+					ValidateFunc: validation.StringInSlice(translate.EncryptionKeyType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceTerminologyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	name := d.Get("name").(string)
+
+	tfMap := d.Get("terminology_data").([]interface{})[0].(map[string]interface{})
+	file, err := terminologyFile(ctx, meta.(*conns.AWSClient).S3Conn(ctx), tfMap)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing Translate Terminology (%s): %s", name, err)
+	}
+
+	input := &translate.ImportTerminologyInput{
+		MergeStrategy: aws.String(translate.MergeStrategyOverwrite),
+		Name:          aws.String(name),
+		TerminologyData: &translate.TerminologyData{
+			File:   file,
+			Format: aws.String(tfMap["format"].(string)),
+		},
+	}
+
+	if v, ok := tfMap["directionality"].(string); ok && v != "" {
+		input.TerminologyData.Directionality = aws.String(v)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_key"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionKey = expandEncryptionKey(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if _, err := conn.ImportTerminologyWithContext(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "importing Translate Terminology (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return append(diags, resourceTerminologyRead(ctx, d, meta)...)
+}
+
+func resourceTerminologyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	terminology, err := FindTerminologyByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Translate Terminology (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Translate Terminology (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", terminology.Arn)
+	d.Set("description", terminology.Description)
+	if err := d.Set("encryption_key", flattenEncryptionKey(terminology.EncryptionKey)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption_key: %s", err)
+	}
+	d.Set("name", terminology.Name)
+	d.Set("size_bytes", terminology.SizeBytes)
+	d.Set("source_language_code", terminology.SourceLanguageCode)
+	d.Set("target_language_codes", aws.StringValueSlice(terminology.TargetLanguageCodes))
+	d.Set("term_count", terminology.TermCount)
+
+	// The terminology file itself is not returned by the API, so its configured source is kept.
+	tfMap := map[string]interface{}{
+		"directionality": aws.StringValue(terminology.Directionality),
+		"format":         aws.StringValue(terminology.Format),
+	}
+	if v, ok := d.GetOk("terminology_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMapOld := v.([]interface{})[0].(map[string]interface{})
+		tfMap["content"] = tfMapOld["content"]
+		tfMap["s3_uri"] = tfMapOld["s3_uri"]
+	}
+	if err := d.Set("terminology_data", []interface{}{tfMap}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting terminology_data: %s", err)
+	}
+
+	return diags
+}
+
+func resourceTerminologyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TranslateConn(ctx)
+
+	log.Printf("[INFO] Deleting Translate Terminology: %s", d.Id())
+	_, err := conn.DeleteTerminologyWithContext(ctx, &translate.DeleteTerminologyInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Translate Terminology (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindTerminologyByName(ctx context.Context, conn *translate.Translate, name string) (*translate.TerminologyProperties, error) {
+	input := &translate.GetTerminologyInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetTerminologyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, translate.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TerminologyProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TerminologyProperties, nil
+}
+
+// terminologyFile returns the terminology file, either inline or read from Amazon S3.
+func terminologyFile(ctx context.Context, conn *s3.S3, tfMap map[string]interface{}) ([]byte, error) {
+	if v, ok := tfMap["content"].(string); ok && v != "" {
+		return []byte(v), nil
+	}
+
+	uri := tfMap["s3_uri"].(string)
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(uri, "s3://"), "/")
+
+	output, err := conn.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading S3 object (%s): %w", uri, err)
+	}
+
+	defer output.Body.Close()
+
+	file, err := io.ReadAll(output.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading S3 object (%s): %w", uri, err)
+	}
+
+	return file, nil
+}
+
+func expandEncryptionKey(tfMap map[string]interface{}) *translate.EncryptionKey {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &translate.EncryptionKey{
+		Id:   aws.String(tfMap["id"].(string)),
+		Type: aws.String(tfMap["type"].(string)),
+	}
+}
+
+func flattenEncryptionKey(apiObject *translate.EncryptionKey) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"id":   aws.StringValue(apiObject.Id),
+		"type": aws.StringValue(apiObject.Type),
+	}}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/translate"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftranslate "github.com/hashicorp/terraform-provider-aws/internal/service/translate"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTranslateTerminology_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.TerminologyProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_terminology.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, translate.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_basic(rName, "Amazon Web Services"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "translate", fmt.Sprintf("terminology/%s/LATEST", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "encryption_key.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "source_language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_language_codes.0", "fr"),
+					resource.TestCheckResourceAttr(resourceName, "term_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "terminology_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "terminology_data.0.directionality", "UNI"),
+					resource.TestCheckResourceAttr(resourceName, "terminology_data.0.format", "CSV"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminology_data.0.content"},
+			},
+			{
+				Config: testAccTerminologyConfig_basic(rName, "Amazon Web Services Inc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "term_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTranslateTerminology_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.TerminologyProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_terminology.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, translate.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_basic(rName, "Amazon Web Services"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tftranslate.ResourceTerminology(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTranslateTerminology_s3URI(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v translate.TerminologyProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_translate_terminology.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, translate.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, translate.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTerminologyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTerminologyConfig_s3URI(rName, "en,fr\nAWS,AWS\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "source_hash", "aws_s3_object.test", "etag"),
+					resource.TestCheckResourceAttr(resourceName, "term_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "terminology_data.0.s3_uri", fmt.Sprintf("s3://%s/terminology.csv", rName)),
+				),
+			},
+			{
+				Config: testAccTerminologyConfig_s3URI(rName, "en,fr\nAWS,AWS\nAmazon,Amazon\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTerminologyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "source_hash", "aws_s3_object.test", "etag"),
+					resource.TestCheckResourceAttr(resourceName, "term_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTerminologyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_translate_terminology" {
+				continue
+			}
+
+			_, err := tftranslate.FindTerminologyByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Translate Terminology %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTerminologyExists(ctx context.Context, n string, v *translate.TerminologyProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TranslateConn(ctx)
+
+		output, err := tftranslate.FindTerminologyByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTerminologyConfig_basic(rName, term string) string {
+	return fmt.Sprintf(`
+resource "aws_translate_terminology" "test" {
+  name = %[1]q
+
+  terminology_data {
+    content = "en,fr\n%[2]s,%[2]s\n"
+    format  = "CSV"
+  }
+}
+`, rName, term)
+}
+
+func testAccTerminologyConfig_s3URI(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "terminology.csv"
+  content = %[2]q
+}
+
+resource "aws_translate_terminology" "test" {
+  name        = %[1]q
+  source_hash = aws_s3_object.test.etag
+
+  terminology_data {
+    format = "CSV"
+    s3_uri = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  }
+}
+`, rName, content)
+}
@@ -0,0 +1,489 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waf
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/waf"
+)
+
+// WebACLMigrationConn is the subset of the AWS WAF Classic API used to translate a web ACL to AWS WAFv2.
+// It is implemented by both the global (WAF) and regional (WAF Regional) clients.
+type WebACLMigrationConn interface {
+	GetByteMatchSetWithContext(aws.Context, *waf.GetByteMatchSetInput, ...request.Option) (*waf.GetByteMatchSetOutput, error)
+	GetGeoMatchSetWithContext(aws.Context, *waf.GetGeoMatchSetInput, ...request.Option) (*waf.GetGeoMatchSetOutput, error)
+	GetIPSetWithContext(aws.Context, *waf.GetIPSetInput, ...request.Option) (*waf.GetIPSetOutput, error)
+	GetRateBasedRuleWithContext(aws.Context, *waf.GetRateBasedRuleInput, ...request.Option) (*waf.GetRateBasedRuleOutput, error)
+	GetRegexMatchSetWithContext(aws.Context, *waf.GetRegexMatchSetInput, ...request.Option) (*waf.GetRegexMatchSetOutput, error)
+	GetRuleWithContext(aws.Context, *waf.GetRuleInput, ...request.Option) (*waf.GetRuleOutput, error)
+	GetSizeConstraintSetWithContext(aws.Context, *waf.GetSizeConstraintSetInput, ...request.Option) (*waf.GetSizeConstraintSetOutput, error)
+	GetSqlInjectionMatchSetWithContext(aws.Context, *waf.GetSqlInjectionMatchSetInput, ...request.Option) (*waf.GetSqlInjectionMatchSetOutput, error)
+	GetWebACLWithContext(aws.Context, *waf.GetWebACLInput, ...request.Option) (*waf.GetWebACLOutput, error)
+	GetXssMatchSetWithContext(aws.Context, *waf.GetXssMatchSetInput, ...request.Option) (*waf.GetXssMatchSetOutput, error)
+}
+
+// WebACLMigration is an AWS WAF Classic web ACL translated to the shape of an AWS WAFv2 CreateWebACL request.
+type WebACLMigration struct {
+	// Name is the name of the classic web ACL.
+	Name string
+	// WebACL is the AWS WAFv2 web ACL, in the JSON structure of the CreateWebACL API.
+	WebACL map[string]interface{}
+	// Unsupported describes the parts of the classic web ACL that need manual migration.
+	Unsupported []string
+}
+
+// MigrateWebACL translates the AWS WAF Classic web ACL with the specified ID to AWS WAFv2.
+// IP sets, regex pattern sets and rule groups have no inline equivalent in AWS WAFv2.
+// They are referenced with placeholder ARNs and reported in Unsupported.
+func MigrateWebACL(ctx context.Context, conn WebACLMigrationConn, webACLID, scope string) (*WebACLMigration, error) {
+	output, err := conn.GetWebACLWithContext(ctx, &waf.GetWebACLInput{
+		WebACLId: aws.String(webACLID),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading WAF Web ACL (%s): %w", webACLID, err)
+	}
+
+	if output == nil || output.WebACL == nil {
+		return nil, fmt.Errorf("reading WAF Web ACL (%s): empty result", webACLID)
+	}
+
+	webACL := output.WebACL
+	m := &webACLMigrator{conn: conn}
+	var rules []interface{}
+
+	for _, v := range webACL.Rules {
+		if v == nil {
+			continue
+		}
+
+		rule, err := m.rule(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if rule != nil {
+			rules = append(rules, rule)
+		}
+	}
+
+	return &WebACLMigration{
+		Name: aws.StringValue(webACL.Name),
+		WebACL: map[string]interface{}{
+			"DefaultAction":    migrateAction(webACL.DefaultAction),
+			"Name":             aws.StringValue(webACL.Name),
+			"Rules":            rules,
+			"Scope":            scope,
+			"VisibilityConfig": migrateVisibilityConfig(aws.StringValue(webACL.MetricName)),
+		},
+		Unsupported: m.unsupported,
+	}, nil
+}
+
+type webACLMigrator struct {
+	conn        WebACLMigrationConn
+	unsupported []string
+}
+
+func (m *webACLMigrator) addUnsupported(format string, a ...interface{}) {
+	m.unsupported = append(m.unsupported, fmt.Sprintf(format, a...))
+}
+
+func (m *webACLMigrator) rule(ctx context.Context, activatedRule *waf.ActivatedRule) (map[string]interface{}, error) {
+	ruleID := aws.StringValue(activatedRule.RuleId)
+
+	var name, metricName string
+	var statement map[string]interface{}
+
+	switch ruleType := aws.StringValue(activatedRule.Type); ruleType {
+	case waf.WafRuleTypeRegular, "":
+		output, err := m.conn.GetRuleWithContext(ctx, &waf.GetRuleInput{
+			RuleId: aws.String(ruleID),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading WAF Rule (%s): %w", ruleID, err)
+		}
+
+		name, metricName = aws.StringValue(output.Rule.Name), aws.StringValue(output.Rule.MetricName)
+		statement, err = m.predicatesStatement(ctx, output.Rule.Predicates)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if statement == nil {
+			m.addUnsupported("rule %s (%s) has unsupported or no conditions and was not migrated", name, ruleID)
+			return nil, nil
+		}
+
+	case waf.WafRuleTypeRateBased:
+		output, err := m.conn.GetRateBasedRuleWithContext(ctx, &waf.GetRateBasedRuleInput{
+			RuleId: aws.String(ruleID),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading WAF Rate Based Rule (%s): %w", ruleID, err)
+		}
+
+		name, metricName = aws.StringValue(output.Rule.Name), aws.StringValue(output.Rule.MetricName)
+		rateBasedStatement := map[string]interface{}{
+			"AggregateKeyType": "IP",
+			"Limit":            aws.Int64Value(output.Rule.RateLimit),
+		}
+
+		// A rate-based rule without conditions applies to all requests.
+		if len(output.Rule.MatchPredicates) > 0 {
+			scopeDownStatement, err := m.predicatesStatement(ctx, output.Rule.MatchPredicates)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if scopeDownStatement == nil {
+				m.addUnsupported("rate-based rule %s (%s) has unsupported conditions and was not migrated", name, ruleID)
+				return nil, nil
+			}
+
+			rateBasedStatement["ScopeDownStatement"] = scopeDownStatement
+		}
+
+		statement = map[string]interface{}{
+			"RateBasedStatement": rateBasedStatement,
+		}
+
+	default:
+		m.addUnsupported("%s rule %s must be migrated manually", ruleType, ruleID)
+		return nil, nil
+	}
+
+	return map[string]interface{}{
+		"Action":           migrateAction(activatedRule.Action),
+		"Name":             name,
+		"Priority":         aws.Int64Value(activatedRule.Priority),
+		"Statement":        statement,
+		"VisibilityConfig": migrateVisibilityConfig(metricName),
+	}, nil
+}
+
+// predicatesStatement returns a statement that matches when all the predicates match.
+// If any predicate can't be translated no statement is returned, as dropping a condition
+// from the AND would make the statement match more requests than the classic rule.
+func (m *webACLMigrator) predicatesStatement(ctx context.Context, predicates []*waf.Predicate) (map[string]interface{}, error) {
+	var statements []map[string]interface{}
+
+	for _, v := range predicates {
+		if v == nil {
+			continue
+		}
+
+		statement, err := m.predicateStatement(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if statement == nil {
+			return nil, nil
+		}
+
+		statements = append(statements, statement)
+	}
+
+	return combineStatements("AndStatement", statements), nil
+}
+
+// predicateStatement returns the statement equivalent to the predicate, or nil if the predicate can't be translated.
+func (m *webACLMigrator) predicateStatement(ctx context.Context, predicate *waf.Predicate) (map[string]interface{}, error) {
+	id := aws.StringValue(predicate.DataId)
+	var statement map[string]interface{}
+
+	switch predicateType := aws.StringValue(predicate.Type); predicateType {
+	case waf.PredicateTypeByteMatch:
+		output, err := m.conn.GetByteMatchSetWithContext(ctx, &waf.GetByteMatchSetInput{
+			ByteMatchSetId: aws.String(id),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading WAF Byte Match Set (%s): %w", id, err)
+		}
+
+		var statements []map[string]interface{}
+		for _, v := range output.ByteMatchSet.ByteMatchTuples {
+			statements = append(statements, map[string]interface{}{
+				"ByteMatchStatement": map[string]interface{}{
+					"FieldToMatch":         migrateFieldToMatch(v.FieldToMatch),
+					"PositionalConstraint": aws.StringValue(v.PositionalConstraint),
+					"SearchString":         v.TargetString,
+					"TextTransformations":  migrateTextTransformation(v.TextTransformation),
+				},
+			})
+		}
+		statement = combineStatements("OrStatement", statements)
+
+	case waf.PredicateTypeGeoMatch:
+		output, err := m.conn.GetGeoMatchSetWithContext(ctx, &waf.GetGeoMatchSetInput{
+			GeoMatchSetId: aws.String(id),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading WAF Geo Match Set (%s): %w", id, err)
+		}
+
+		var countryCodes []string
+		for _, v := range output.GeoMatchSet.GeoMatchConstraints {
+			countryCodes = append(countryCodes, aws.StringValue(v.Value))
+		}
+
+		if len(countryCodes) > 0 {
+			statement = map[string]interface{}{
+				"GeoMatchStatement": map[string]interface{}{
+					"CountryCodes": countryCodes,
+				},
+			}
+		}
+
+	case waf.PredicateTypeIpmatch:
+		output, err := m.conn.GetIPSetWithContext(ctx, &waf.GetIPSetInput{
+			IPSetId: aws.String(id),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading WAF IPSet (%s): %w", id, err)
+		}
+
+		var addresses []string
+		for _, v := range output.IPSet.IPSetDescriptors {
+			addresses = append(addresses, aws.StringValue(v.Value))
+		}
+
+		m.addUnsupported("IP set %s (%s) must be recreated as an aws_wafv2_ip_set with addresses [%s]", aws.StringValue(output.IPSet.Name), id, strings.Join(addresses, ", "))
+		statement = map[string]interface{}{
+			"IPSetReferenceStatement": map[string]interface{}{
+				"ARN": migrationPlaceholderARN("ip_set", id),
+			},
+		}
+
+	case waf.PredicateTypeRegexMatch:
+		output, err := m.conn.GetRegexMatchSetWithContext(ctx, &waf.GetRegexMatchSetInput{
+			RegexMatchSetId: aws.String(id),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading WAF Regex Match Set (%s): %w", id, err)
+		}
+
+		var statements []map[string]interface{}
+		for _, v := range output.RegexMatchSet.RegexMatchTuples {
+			regexPatternSetID := aws.StringValue(v.RegexPatternSetId)
+
+			m.addUnsupported("regex pattern set %s must be recreated as an aws_wafv2_regex_pattern_set", regexPatternSetID)
+			statements = append(statements, map[string]interface{}{
+				"RegexPatternSetReferenceStatement": map[string]interface{}{
+					"ARN":                 migrationPlaceholderARN("regex_pattern_set", regexPatternSetID),
+					"FieldToMatch":        migrateFieldToMatch(v.FieldToMatch),
+					"TextTransformations": migrateTextTransformation(v.TextTransformation),
+				},
+			})
+		}
+		statement = combineStatements("OrStatement", statements)
+
+	case waf.PredicateTypeSizeConstraint:
+		output, err := m.conn.GetSizeConstraintSetWithContext(ctx, &waf.GetSizeConstraintSetInput{
+			SizeConstraintSetId: aws.String(id),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading WAF Size Constraint Set (%s): %w", id, err)
+		}
+
+		var statements []map[string]interface{}
+		for _, v := range output.SizeConstraintSet.SizeConstraints {
+			statements = append(statements, map[string]interface{}{
+				"SizeConstraintStatement": map[string]interface{}{
+					"ComparisonOperator":  aws.StringValue(v.ComparisonOperator),
+					"FieldToMatch":        migrateFieldToMatch(v.FieldToMatch),
+					"Size":                aws.Int64Value(v.Size),
+					"TextTransformations": migrateTextTransformation(v.TextTransformation),
+				},
+			})
+		}
+		statement = combineStatements("OrStatement", statements)
+
+	case waf.PredicateTypeSqlInjectionMatch:
+		output, err := m.conn.GetSqlInjectionMatchSetWithContext(ctx, &waf.GetSqlInjectionMatchSetInput{
+			SqlInjectionMatchSetId: aws.String(id),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading WAF SQL Injection Match Set (%s): %w", id, err)
+		}
+
+		var statements []map[string]interface{}
+		for _, v := range output.SqlInjectionMatchSet.SqlInjectionMatchTuples {
+			statements = append(statements, map[string]interface{}{
+				"SqliMatchStatement": map[string]interface{}{
+					"FieldToMatch":        migrateFieldToMatch(v.FieldToMatch),
+					"TextTransformations": migrateTextTransformation(v.TextTransformation),
+				},
+			})
+		}
+		statement = combineStatements("OrStatement", statements)
+
+	case waf.PredicateTypeXssMatch:
+		output, err := m.conn.GetXssMatchSetWithContext(ctx, &waf.GetXssMatchSetInput{
+			XssMatchSetId: aws.String(id),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading WAF XSS Match Set (%s): %w", id, err)
+		}
+
+		var statements []map[string]interface{}
+		for _, v := range output.XssMatchSet.XssMatchTuples {
+			statements = append(statements, map[string]interface{}{
+				"XssMatchStatement": map[string]interface{}{
+					"FieldToMatch":        migrateFieldToMatch(v.FieldToMatch),
+					"TextTransformations": migrateTextTransformation(v.TextTransformation),
+				},
+			})
+		}
+		statement = combineStatements("OrStatement", statements)
+
+	default:
+		m.addUnsupported("%s condition %s must be migrated manually", predicateType, id)
+		return nil, nil
+	}
+
+	if statement == nil {
+		m.addUnsupported("%s condition %s is empty and was not migrated", aws.StringValue(predicate.Type), id)
+		return nil, nil
+	}
+
+	if !fieldsToMatchSupported(statement) {
+		m.addUnsupported("%s condition %s matches an unsupported field and was not migrated", aws.StringValue(predicate.Type), id)
+		return nil, nil
+	}
+
+	if aws.BoolValue(predicate.Negated) {
+		statement = map[string]interface{}{
+			"NotStatement": map[string]interface{}{
+				"Statement": statement,
+			},
+		}
+	}
+
+	return statement, nil
+}
+
+// combineStatements returns the single statement, or a statement of the specified logical type wrapping all the statements.
+func combineStatements(logicalType string, statements []map[string]interface{}) map[string]interface{} {
+	switch len(statements) {
+	case 0:
+		return nil
+	case 1:
+		return statements[0]
+	default:
+		return map[string]interface{}{
+			logicalType: map[string]interface{}{
+				"Statements": statements,
+			},
+		}
+	}
+}
+
+// fieldsToMatchSupported returns whether every FieldToMatch in the statement was translated.
+func fieldsToMatchSupported(statement map[string]interface{}) bool {
+	for k, v := range statement {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			if k == "FieldToMatch" && v == nil {
+				return false
+			}
+			if !fieldsToMatchSupported(v) {
+				return false
+			}
+		case []map[string]interface{}:
+			for _, v := range v {
+				if !fieldsToMatchSupported(v) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+func migrateAction(apiObject *waf.WafAction) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	switch aws.StringValue(apiObject.Type) {
+	case waf.WafActionTypeAllow:
+		return map[string]interface{}{"Allow": map[string]interface{}{}}
+	case waf.WafActionTypeBlock:
+		return map[string]interface{}{"Block": map[string]interface{}{}}
+	case waf.WafActionTypeCount:
+		return map[string]interface{}{"Count": map[string]interface{}{}}
+	default:
+		return nil
+	}
+}
+
+func migrateFieldToMatch(apiObject *waf.FieldToMatch) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	data := aws.StringValue(apiObject.Data)
+
+	switch aws.StringValue(apiObject.Type) {
+	case waf.MatchFieldTypeAllQueryArgs:
+		return map[string]interface{}{"AllQueryArguments": map[string]interface{}{}}
+	case waf.MatchFieldTypeBody:
+		return map[string]interface{}{"Body": map[string]interface{}{}}
+	case waf.MatchFieldTypeHeader:
+		return map[string]interface{}{"SingleHeader": map[string]interface{}{"Name": strings.ToLower(data)}}
+	case waf.MatchFieldTypeMethod:
+		return map[string]interface{}{"Method": map[string]interface{}{}}
+	case waf.MatchFieldTypeQueryString:
+		return map[string]interface{}{"QueryString": map[string]interface{}{}}
+	case waf.MatchFieldTypeSingleQueryArg:
+		return map[string]interface{}{"SingleQueryArgument": map[string]interface{}{"Name": strings.ToLower(data)}}
+	case waf.MatchFieldTypeUri:
+		return map[string]interface{}{"UriPath": map[string]interface{}{}}
+	default:
+		return nil
+	}
+}
+
+func migrateTextTransformation(v *string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"Priority": 0,
+			"Type":     aws.StringValue(v),
+		},
+	}
+}
+
+func migrateVisibilityConfig(metricName string) map[string]interface{} {
+	return map[string]interface{}{
+		"CloudWatchMetricsEnabled": true,
+		"MetricName":               metricName,
+		"SampledRequestsEnabled":   true,
+	}
+}
+
+// migrationPlaceholderARN returns a placeholder for the ARN of the AWS WAFv2 resource replacing the classic resource with the specified ID.
+func migrationPlaceholderARN(resourceType, id string) string {
+	return fmt.Sprintf("<ARN of aws_wafv2_%s replacing %s>", resourceType, id)
+}
@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waf
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/waf"
+)
+
+type mockWebACLMigrationConn struct {
+	WebACLMigrationConn
+
+	byteMatchSets  map[string]*waf.ByteMatchSet
+	geoMatchSets   map[string]*waf.GeoMatchSet
+	ipSets         map[string]*waf.IPSet
+	rateBasedRules map[string]*waf.RateBasedRule
+	rules          map[string]*waf.Rule
+	webACLs        map[string]*waf.WebACL
+}
+
+func (c *mockWebACLMigrationConn) GetByteMatchSetWithContext(_ aws.Context, input *waf.GetByteMatchSetInput, _ ...request.Option) (*waf.GetByteMatchSetOutput, error) {
+	return &waf.GetByteMatchSetOutput{ByteMatchSet: c.byteMatchSets[aws.StringValue(input.ByteMatchSetId)]}, nil
+}
+
+func (c *mockWebACLMigrationConn) GetGeoMatchSetWithContext(_ aws.Context, input *waf.GetGeoMatchSetInput, _ ...request.Option) (*waf.GetGeoMatchSetOutput, error) {
+	return &waf.GetGeoMatchSetOutput{GeoMatchSet: c.geoMatchSets[aws.StringValue(input.GeoMatchSetId)]}, nil
+}
+
+func (c *mockWebACLMigrationConn) GetIPSetWithContext(_ aws.Context, input *waf.GetIPSetInput, _ ...request.Option) (*waf.GetIPSetOutput, error) {
+	return &waf.GetIPSetOutput{IPSet: c.ipSets[aws.StringValue(input.IPSetId)]}, nil
+}
+
+func (c *mockWebACLMigrationConn) GetRateBasedRuleWithContext(_ aws.Context, input *waf.GetRateBasedRuleInput, _ ...request.Option) (*waf.GetRateBasedRuleOutput, error) {
+	return &waf.GetRateBasedRuleOutput{Rule: c.rateBasedRules[aws.StringValue(input.RuleId)]}, nil
+}
+
+func (c *mockWebACLMigrationConn) GetRuleWithContext(_ aws.Context, input *waf.GetRuleInput, _ ...request.Option) (*waf.GetRuleOutput, error) {
+	return &waf.GetRuleOutput{Rule: c.rules[aws.StringValue(input.RuleId)]}, nil
+}
+
+func (c *mockWebACLMigrationConn) GetWebACLWithContext(_ aws.Context, input *waf.GetWebACLInput, _ ...request.Option) (*waf.GetWebACLOutput, error) {
+	return &waf.GetWebACLOutput{WebACL: c.webACLs[aws.StringValue(input.WebACLId)]}, nil
+}
+
+func newMockWebACLMigrationConn() *mockWebACLMigrationConn {
+	return &mockWebACLMigrationConn{
+		byteMatchSets: map[string]*waf.ByteMatchSet{
+			"bms-one": {
+				ByteMatchTuples: []*waf.ByteMatchTuple{
+					{
+						FieldToMatch:         &waf.FieldToMatch{Type: aws.String(waf.MatchFieldTypeHeader), Data: aws.String("User-Agent")},
+						PositionalConstraint: aws.String(waf.PositionalConstraintContains),
+						TargetString:         []byte("badbot"),
+						TextTransformation:   aws.String(waf.TextTransformationLowercase),
+					},
+				},
+			},
+			"bms-two": {
+				ByteMatchTuples: []*waf.ByteMatchTuple{
+					{
+						FieldToMatch:         &waf.FieldToMatch{Type: aws.String(waf.MatchFieldTypeUri)},
+						PositionalConstraint: aws.String(waf.PositionalConstraintStartsWith),
+						TargetString:         []byte("/admin"),
+						TextTransformation:   aws.String(waf.TextTransformationNone),
+					},
+					{
+						FieldToMatch:         &waf.FieldToMatch{Type: aws.String(waf.MatchFieldTypeQueryString)},
+						PositionalConstraint: aws.String(waf.PositionalConstraintExactly),
+						TargetString:         []byte("debug"),
+						TextTransformation:   aws.String(waf.TextTransformationNone),
+					},
+				},
+			},
+			"bms-unsupported-field": {
+				ByteMatchTuples: []*waf.ByteMatchTuple{
+					{
+						FieldToMatch:         &waf.FieldToMatch{Type: aws.String("UNKNOWN")},
+						PositionalConstraint: aws.String(waf.PositionalConstraintContains),
+						TargetString:         []byte("x"),
+						TextTransformation:   aws.String(waf.TextTransformationNone),
+					},
+				},
+			},
+		},
+		geoMatchSets: map[string]*waf.GeoMatchSet{
+			"gms-empty": {},
+			"gms-us": {
+				GeoMatchConstraints: []*waf.GeoMatchConstraint{
+					{Type: aws.String(waf.GeoMatchConstraintTypeCountry), Value: aws.String("US")},
+				},
+			},
+		},
+		ipSets: map[string]*waf.IPSet{
+			"ips-one": {
+				Name: aws.String("office"),
+				IPSetDescriptors: []*waf.IPSetDescriptor{
+					{Type: aws.String(waf.IPSetDescriptorTypeIpv4), Value: aws.String("192.0.2.0/24")},
+				},
+			},
+		},
+		rateBasedRules: map[string]*waf.RateBasedRule{
+			"rbr-all": {
+				Name:       aws.String("rate-all"),
+				MetricName: aws.String("rateall"),
+				RateLimit:  aws.Int64(2000),
+			},
+			"rbr-partial": {
+				Name:       aws.String("rate-partial"),
+				MetricName: aws.String("ratepartial"),
+				RateLimit:  aws.Int64(2000),
+				MatchPredicates: []*waf.Predicate{
+					{DataId: aws.String("bms-one"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeByteMatch)},
+					{DataId: aws.String("gms-empty"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeGeoMatch)},
+				},
+			},
+		},
+		rules: map[string]*waf.Rule{
+			"rule-supported": {
+				Name:       aws.String("supported"),
+				MetricName: aws.String("supported"),
+				Predicates: []*waf.Predicate{
+					{DataId: aws.String("bms-one"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeByteMatch)},
+					{DataId: aws.String("gms-us"), Negated: aws.Bool(true), Type: aws.String(waf.PredicateTypeGeoMatch)},
+				},
+			},
+			"rule-partial": {
+				Name:       aws.String("partial"),
+				MetricName: aws.String("partial"),
+				Predicates: []*waf.Predicate{
+					{DataId: aws.String("bms-one"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeByteMatch)},
+					{DataId: aws.String("gms-empty"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeGeoMatch)},
+				},
+			},
+		},
+		webACLs: map[string]*waf.WebACL{
+			"acl": {
+				Name:          aws.String("acl"),
+				MetricName:    aws.String("acl"),
+				DefaultAction: &waf.WafAction{Type: aws.String(waf.WafActionTypeBlock)},
+				Rules: []*waf.ActivatedRule{
+					{RuleId: aws.String("rule-supported"), Priority: aws.Int64(1), Action: &waf.WafAction{Type: aws.String(waf.WafActionTypeAllow)}, Type: aws.String(waf.WafRuleTypeRegular)},
+					{RuleId: aws.String("rule-partial"), Priority: aws.Int64(2), Action: &waf.WafAction{Type: aws.String(waf.WafActionTypeAllow)}, Type: aws.String(waf.WafRuleTypeRegular)},
+					{RuleId: aws.String("rbr-all"), Priority: aws.Int64(3), Action: &waf.WafAction{Type: aws.String(waf.WafActionTypeBlock)}, Type: aws.String(waf.WafRuleTypeRateBased)},
+					{RuleId: aws.String("rbr-partial"), Priority: aws.Int64(4), Action: &waf.WafAction{Type: aws.String(waf.WafActionTypeBlock)}, Type: aws.String(waf.WafRuleTypeRateBased)},
+				},
+			},
+		},
+	}
+}
+
+func TestCombineStatements(t *testing.T) {
+	t.Parallel()
+
+	a := map[string]interface{}{"A": map[string]interface{}{}}
+	b := map[string]interface{}{"B": map[string]interface{}{}}
+
+	testCases := map[string]struct {
+		statements []map[string]interface{}
+		want       map[string]interface{}
+	}{
+		"none": {},
+		"one": {
+			statements: []map[string]interface{}{a},
+			want:       a,
+		},
+		"many": {
+			statements: []map[string]interface{}{a, b},
+			want: map[string]interface{}{
+				"OrStatement": map[string]interface{}{
+					"Statements": []map[string]interface{}{a, b},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := combineStatements("OrStatement", testCase.statements); !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestWebACLMigratorPredicateStatement(t *testing.T) {
+	t.Parallel()
+
+	geoUS := map[string]interface{}{
+		"GeoMatchStatement": map[string]interface{}{
+			"CountryCodes": []string{"US"},
+		},
+	}
+
+	testCases := map[string]struct {
+		predicate       *waf.Predicate
+		want            map[string]interface{}
+		wantUnsupported int
+	}{
+		"byte match single tuple": {
+			predicate: &waf.Predicate{DataId: aws.String("bms-one"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeByteMatch)},
+			want: map[string]interface{}{
+				"ByteMatchStatement": map[string]interface{}{
+					"FieldToMatch":         map[string]interface{}{"SingleHeader": map[string]interface{}{"Name": "user-agent"}},
+					"PositionalConstraint": waf.PositionalConstraintContains,
+					"SearchString":         []byte("badbot"),
+					"TextTransformations":  []interface{}{map[string]interface{}{"Priority": 0, "Type": waf.TextTransformationLowercase}},
+				},
+			},
+		},
+		"byte match multiple tuples": {
+			predicate: &waf.Predicate{DataId: aws.String("bms-two"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeByteMatch)},
+			want: map[string]interface{}{
+				"OrStatement": map[string]interface{}{
+					"Statements": []map[string]interface{}{
+						{
+							"ByteMatchStatement": map[string]interface{}{
+								"FieldToMatch":         map[string]interface{}{"UriPath": map[string]interface{}{}},
+								"PositionalConstraint": waf.PositionalConstraintStartsWith,
+								"SearchString":         []byte("/admin"),
+								"TextTransformations":  []interface{}{map[string]interface{}{"Priority": 0, "Type": waf.TextTransformationNone}},
+							},
+						},
+						{
+							"ByteMatchStatement": map[string]interface{}{
+								"FieldToMatch":         map[string]interface{}{"QueryString": map[string]interface{}{}},
+								"PositionalConstraint": waf.PositionalConstraintExactly,
+								"SearchString":         []byte("debug"),
+								"TextTransformations":  []interface{}{map[string]interface{}{"Priority": 0, "Type": waf.TextTransformationNone}},
+							},
+						},
+					},
+				},
+			},
+		},
+		"unsupported field to match": {
+			predicate:       &waf.Predicate{DataId: aws.String("bms-unsupported-field"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeByteMatch)},
+			wantUnsupported: 1,
+		},
+		"geo match": {
+			predicate: &waf.Predicate{DataId: aws.String("gms-us"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeGeoMatch)},
+			want:      geoUS,
+		},
+		"negated": {
+			predicate: &waf.Predicate{DataId: aws.String("gms-us"), Negated: aws.Bool(true), Type: aws.String(waf.PredicateTypeGeoMatch)},
+			want: map[string]interface{}{
+				"NotStatement": map[string]interface{}{
+					"Statement": geoUS,
+				},
+			},
+		},
+		"empty geo match": {
+			predicate:       &waf.Predicate{DataId: aws.String("gms-empty"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeGeoMatch)},
+			wantUnsupported: 1,
+		},
+		"negated empty geo match": {
+			predicate:       &waf.Predicate{DataId: aws.String("gms-empty"), Negated: aws.Bool(true), Type: aws.String(waf.PredicateTypeGeoMatch)},
+			wantUnsupported: 1,
+		},
+		"IP set placeholder": {
+			predicate: &waf.Predicate{DataId: aws.String("ips-one"), Negated: aws.Bool(false), Type: aws.String(waf.PredicateTypeIpmatch)},
+			want: map[string]interface{}{
+				"IPSetReferenceStatement": map[string]interface{}{
+					"ARN": migrationPlaceholderARN("ip_set", "ips-one"),
+				},
+			},
+			wantUnsupported: 1,
+		},
+		"unknown type": {
+			predicate:       &waf.Predicate{DataId: aws.String("x"), Negated: aws.Bool(false), Type: aws.String("Unknown")},
+			wantUnsupported: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &webACLMigrator{conn: newMockWebACLMigrationConn()}
+			got, err := m.predicateStatement(context.Background(), testCase.predicate)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+
+			if got, want := len(m.unsupported), testCase.wantUnsupported; got != want {
+				t.Errorf("got %d unsupported (%v), want %d", got, m.unsupported, want)
+			}
+		})
+	}
+}
+
+func TestMigrateWebACL_partialRules(t *testing.T) {
+	t.Parallel()
+
+	output, err := MigrateWebACL(context.Background(), newMockWebACLMigrationConn(), "acl", "CLOUDFRONT")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+	for _, v := range output.WebACL["Rules"].([]interface{}) {
+		rule := v.(map[string]interface{})
+		names = append(names, rule["Name"].(string))
+
+		if rule["Name"] == "rate-all" {
+			if _, ok := rule["Statement"].(map[string]interface{})["RateBasedStatement"].(map[string]interface{})["ScopeDownStatement"]; ok {
+				t.Errorf("rule %s: unexpected ScopeDownStatement", rule["Name"])
+			}
+		}
+	}
+
+	// Rules with any condition that can't be translated must not be migrated at all.
+	if got, want := names, []string{"supported", "rate-all"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got rules %v, want %v", got, want)
+	}
+
+	if got, want := len(output.Unsupported), 4; got != want {
+		t.Errorf("got %d unsupported (%v), want %d", got, output.Unsupported, want)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waf
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_waf_web_acl_wafv2_migration")
+func DataSourceWebACLWAFV2Migration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceWebACLWAFV2MigrationRead,
+
+		Schema: WebACLWAFV2MigrationSchema(),
+	}
+}
+
+func dataSourceWebACLWAFV2MigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFConn(ctx)
+
+	webACLID := d.Get("web_acl_id").(string)
+	migration, err := MigrateWebACL(ctx, conn, webACLID, "CLOUDFRONT")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "migrating WAF Web ACL (%s): %s", webACLID, err)
+	}
+
+	if err := SetWebACLWAFV2Migration(d, migration); err != nil {
+		return sdkdiag.AppendErrorf(diags, "migrating WAF Web ACL (%s): %s", webACLID, err)
+	}
+
+	d.SetId(webACLID)
+
+	return diags
+}
+
+func WebACLWAFV2MigrationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"unsupported": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"web_acl_id": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"wafv2_web_acl_json": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func SetWebACLWAFV2Migration(d *schema.ResourceData, migration *WebACLMigration) error {
+	v, err := json.Marshal(migration.WebACL)
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", migration.Name)
+	d.Set("unsupported", migration.Unsupported)
+	d.Set("wafv2_web_acl_json", string(v))
+
+	return nil
+}
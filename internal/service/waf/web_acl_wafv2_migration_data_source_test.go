@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package waf_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/waf"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccWAFWebACLWAFV2MigrationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_waf_web_acl.test"
+	dataSourceName := "data.aws_waf_web_acl_wafv2_migration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, waf.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, waf.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLWAFV2MigrationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported.#", "1"),
+					resource.TestMatchResourceAttr(dataSourceName, "unsupported.0", regexache.MustCompile(`192\.0\.7\.0/24`)),
+					resource.TestMatchResourceAttr(dataSourceName, "wafv2_web_acl_json", regexache.MustCompile(`"Scope":"CLOUDFRONT"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "wafv2_web_acl_json", regexache.MustCompile(`"NotStatement":\{"Statement":\{"IPSetReferenceStatement"`)),
+					resource.TestMatchResourceAttr(dataSourceName, "wafv2_web_acl_json", regexache.MustCompile(`"XssMatchStatement"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebACLWAFV2MigrationDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_waf_ipset" "test" {
+  name = %[1]q
+
+  ip_set_descriptors {
+    type  = "IPV4"
+    value = "192.0.7.0/24"
+  }
+}
+
+resource "aws_waf_xss_match_set" "test" {
+  name = %[1]q
+
+  xss_match_tuples {
+    text_transformation = "NONE"
+
+    field_to_match {
+      type = "URI"
+    }
+  }
+}
+
+resource "aws_waf_rule" "test" {
+  name        = %[1]q
+  metric_name = "tfWebACLMigration"
+
+  predicates {
+    type    = "IPMatch"
+    data_id = aws_waf_ipset.test.id
+    negated = true
+  }
+
+  predicates {
+    type    = "XssMatch"
+    data_id = aws_waf_xss_match_set.test.id
+    negated = false
+  }
+}
+
+resource "aws_waf_web_acl" "test" {
+  name        = %[1]q
+  metric_name = "tfWebACLMigration"
+
+  default_action {
+    type = "ALLOW"
+  }
+
+  rules {
+    action {
+      type = "BLOCK"
+    }
+
+    priority = 1
+    rule_id  = aws_waf_rule.test.id
+  }
+}
+
+data "aws_waf_web_acl_wafv2_migration" "test" {
+  web_acl_id = aws_waf_web_acl.test.id
+}
+`, rName)
+}
@@ -45,6 +45,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceWebACL,
 			TypeName: "aws_wafregional_web_acl",
 		},
+		{
+			Factory:  DataSourceWebACLWAFV2Migration,
+			TypeName: "aws_wafregional_web_acl_wafv2_migration",
+		},
 	}
 }
 
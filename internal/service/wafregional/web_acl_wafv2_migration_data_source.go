@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafregional
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfwaf "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
+)
+
+// @SDKDataSource("aws_wafregional_web_acl_wafv2_migration")
+func DataSourceWebACLWAFV2Migration() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceWebACLWAFV2MigrationRead,
+
+		Schema: tfwaf.WebACLWAFV2MigrationSchema(),
+	}
+}
+
+func dataSourceWebACLWAFV2MigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFRegionalConn(ctx)
+
+	webACLID := d.Get("web_acl_id").(string)
+	migration, err := tfwaf.MigrateWebACL(ctx, conn, webACLID, "REGIONAL")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "migrating WAF Regional Web ACL (%s): %s", webACLID, err)
+	}
+
+	if err := tfwaf.SetWebACLWAFV2Migration(d, migration); err != nil {
+		return sdkdiag.AppendErrorf(diags, "migrating WAF Regional Web ACL (%s): %s", webACLID, err)
+	}
+
+	d.SetId(webACLID)
+
+	return diags
+}
@@ -34,7 +34,7 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 		return true
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := PoliciesAreEquivalent(old, new)
 	if err != nil {
 		return false
 	}
@@ -42,6 +42,127 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 	return equivalent
 }
 
+// PoliciesAreEquivalent returns whether two IAM policy documents grant the same permissions.
+// In addition to the statement, element and single-value-list equivalences handled by
+// awspolicyequivalence, the documents are first normalized so that the following are also
+// considered equivalent:
+//   - a "*" principal and {"AWS": "*"}
+//   - an AWS account ID principal and the account's root user ARN
+//   - action names that differ only in case
+//   - condition keys that differ only in case
+//   - boolean and numeric condition values and their string forms
+func PoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	return awspolicy.PoliciesAreEquivalent(normalizePolicyDocument(policy1), normalizePolicyDocument(policy2))
+}
+
+var rootUserARNRegexp = regexache.MustCompile(`^arn:[^:]+:iam::(\d{12}):root$`)
+
+// normalizePolicyDocument rewrites a policy document so that semantically equivalent forms
+// compare equal. Documents that are not JSON objects are returned unchanged.
+func normalizePolicyDocument(policy string) string {
+	// Numbers are decoded as json.Number so that condition values keep their exact form,
+	// e.g. large account or resource IDs that don't fit in a float64.
+	var document map[string]interface{}
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil || decoder.More() {
+		return policy
+	}
+
+	switch v := document["Statement"].(type) {
+	case map[string]interface{}:
+		normalizePolicyStatement(v)
+	case []interface{}:
+		for _, statement := range v {
+			if statement, ok := statement.(map[string]interface{}); ok {
+				normalizePolicyStatement(statement)
+			}
+		}
+	}
+
+	b, err := json.Marshal(document)
+	if err != nil {
+		return policy
+	}
+
+	return string(b)
+}
+
+func normalizePolicyStatement(statement map[string]interface{}) {
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		switch v := statement[k].(type) {
+		case string:
+			if v == "*" {
+				statement[k] = map[string]interface{}{"AWS": "*"}
+			}
+		case map[string]interface{}:
+			if principals, ok := v["AWS"]; ok {
+				v["AWS"] = mapPolicyStrings(principals, func(s string) string {
+					if m := rootUserARNRegexp.FindStringSubmatch(s); m != nil {
+						return m[1]
+					}
+
+					return s
+				})
+			}
+		}
+	}
+
+	for _, k := range []string{"Action", "NotAction"} {
+		if v, ok := statement[k]; ok {
+			statement[k] = mapPolicyStrings(v, strings.ToLower)
+		}
+	}
+
+	if conditions, ok := statement["Condition"].(map[string]interface{}); ok {
+		for operator, v := range conditions {
+			block, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			normalized := make(map[string]interface{}, len(block))
+			for key, values := range block {
+				normalized[strings.ToLower(key)] = stringifyPolicyConditionValues(values)
+			}
+			conditions[operator] = normalized
+		}
+	}
+}
+
+// mapPolicyStrings applies f to a policy element that is either a string or a list of strings.
+func mapPolicyStrings(v interface{}, f func(string) string) interface{} {
+	switch v := v.(type) {
+	case string:
+		return f(v)
+	case []interface{}:
+		for i, s := range v {
+			if s, ok := s.(string); ok {
+				v[i] = f(s)
+			}
+		}
+		return v
+	}
+
+	return v
+}
+
+func stringifyPolicyConditionValues(v interface{}) interface{} {
+	switch v := v.(type) {
+	case bool:
+		return fmt.Sprint(v)
+	case json.Number:
+		return v.String()
+	case []interface{}:
+		for i, value := range v {
+			v[i] = stringifyPolicyConditionValues(value)
+		}
+		return v
+	}
+
+	return v
+}
+
 func SuppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
 	return JSONStringsEqual(old, new)
 }
@@ -119,7 +240,7 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 		return new, nil
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := PoliciesAreEquivalent(old, new)
 
 	if err != nil {
 		return "", err
@@ -369,6 +369,101 @@ func TestSecondJSONUnlessEquivalent(t *testing.T) {
 	}
 }
 
+func TestPoliciesAreEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		policy1 string
+		policy2 string
+		want    bool
+	}{
+		{
+			name:    "statement and element order",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			policy2: `{"Statement":[{"Resource":"*","Action":"s3:DeleteObject","Effect":"Deny"},{"Resource":["*"],"Action":["s3:PutObject","s3:GetObject"],"Effect":"Allow"}],"Version":"2012-10-17"}`,
+			want:    true,
+		},
+		{
+			name:    "wildcard principal",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			want:    true,
+		},
+		{
+			name:    "account ID and root user ARN principals",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012","arn:aws:iam::210987654321:role/example"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::210987654321:role/example"]},"Action":"s3:GetObject","Resource":"*"}]}`,
+			want:    true,
+		},
+		{
+			name:    "different accounts",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"123456789012"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			want:    false,
+		},
+		{
+			name:    "action case",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::example/*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"S3:getobject","NotResource":"arn:aws:s3:::example/*"}]}`,
+			want:    true,
+		},
+		{
+			name:    "resource case",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::Example/*"}]}`,
+			want:    false,
+		},
+		{
+			name:    "condition key case and value types",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false},"NumericLessThan":{"s3:TlsVersion":1.2}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:securetransport":"false"},"NumericLessThan":{"s3:TlsVersion":["1.2"]}}}]}`,
+			want:    true,
+		},
+		{
+			name:    "different condition values",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}}]}`,
+			want:    false,
+		},
+		{
+			name:    "large integer condition value",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalAccount":123456789012345678}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalAccount":"123456789012345678"}}}]}`,
+			want:    true,
+		},
+		{
+			name:    "large integer condition values differing beyond float64 precision",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalAccount":123456789012345678}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalAccount":"123456789012345679"}}}]}`,
+			want:    false,
+		},
+		{
+			name:    "small integer condition value",
+			policy1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"NumericEquals":{"s3:max-keys":10}}}]}`,
+			policy2: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"NumericEquals":{"s3:max-keys":"10"}}}]}`,
+			want:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PoliciesAreEquivalent(testCase.policy1, testCase.policy2)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestNormalizeJSONOrYAMLString(t *testing.T) {
 	t.Parallel()
 
@@ -44,6 +44,7 @@ const (
 	CodeBuild                    = "codebuild"
 	CodeCatalyst                 = "codecatalyst"
 	CodeCommit                   = "codecommit"
+	CodeGuruProfiler             = "codeguruprofiler"
 	CodeGuruReviewer             = "codegurureviewer"
 	CodePipeline                 = "codepipeline"
 	CodeStarConnections          = "codestarconnections"
@@ -64,6 +65,7 @@ const (
 	DataSync                     = "datasync"
 	Deploy                       = "deploy"
 	Detective                    = "detective"
+	DevOpsGuru                   = "devopsguru"
 	DeviceFarm                   = "devicefarm"
 	DirectConnect                = "directconnect"
 	DocDB                        = "docdb"
@@ -88,6 +90,7 @@ const (
 	Evidently                    = "evidently"
 	FIS                          = "fis"
 	FMS                          = "fms"
+	FraudDetector                = "frauddetector"
 	FSx                          = "fsx"
 	FinSpace                     = "finspace"
 	Firehose                     = "firehose"
@@ -129,6 +132,8 @@ const (
 	MQ                           = "mq"
 	MWAA                         = "mwaa"
 	Macie2                       = "macie2"
+	ManagedBlockchain            = "managedblockchain"
+	ManagedBlockchainQuery       = "managedblockchainquery"
 	MediaConnect                 = "mediaconnect"
 	MediaConvert                 = "mediaconvert"
 	MediaLive                    = "medialive"
@@ -146,6 +151,7 @@ const (
 	Outposts                     = "outposts"
 	Pinpoint                     = "pinpoint"
 	Pipes                        = "pipes"
+	Polly                        = "polly"
 	Pricing                      = "pricing"
 	QLDB                         = "qldb"
 	QuickSight                   = "quicksight"
@@ -156,6 +162,7 @@ const (
 	Redshift                     = "redshift"
 	RedshiftData                 = "redshiftdata"
 	RedshiftServerless           = "redshiftserverless"
+	Rekognition                  = "rekognition"
 	ResourceExplorer2            = "resourceexplorer2"
 	ResourceGroups               = "resourcegroups"
 	ResourceGroupsTaggingAPI     = "resourcegroupstaggingapi"
@@ -197,6 +204,7 @@ const (
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
 	Transfer                     = "transfer"
+	Translate                    = "translate"
 	VPCLattice                   = "vpclattice"
 	VerifiedPermissions          = "verifiedpermissions"
 	WAF                          = "waf"